@@ -2,11 +2,14 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"ilyaytrewq/PR_assigning_service/internal/api"
@@ -23,6 +26,26 @@ func main() {
 	dbName := getEnv("DB_NAME", "pr_assigning_service")
 	dbSSLMode := getEnv("DB_SSLMODE", "disable")
 
+	seed := rand.Uint64()
+	if v := getEnv("ASSIGNMENT_SEED", ""); v != "" {
+		parsed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			log.Fatalf("invalid ASSIGNMENT_SEED %q: %v", v, err)
+		}
+		seed = parsed
+	}
+	rng := service.NewRand(seed)
+
+	strategies, err := service.NewStrategies(
+		getEnv("ASSIGNMENT_STRATEGY", service.StrategyLeastLoaded),
+		getEnv("REASSIGN_STRATEGY", service.StrategyRandom),
+		rng,
+	)
+	if err != nil {
+		log.Fatalf("failed to configure assignment strategies: %v", err)
+	}
+	strategy, reassignStrategy := strategies.Defaults()
+
 	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		dbHost, dbPort, dbUser, dbPassword, dbName, dbSSLMode,
@@ -45,12 +68,30 @@ func main() {
 	}
 
 	log.Println("connected to postgres")
+	log.Printf("using %s assignment strategy, %s reassign strategy by default, seed=%d", strategy.Name(), reassignStrategy.Name(), seed)
 
 	userRepo := repo.NewUserRepository(db)
 	teamRepo := repo.NewTeamRepo(db)
 	prRepo := repo.NewPRRepo(db)
+	codeOwnersRepo := repo.NewCodeOwnersRepo(db)
+
+	services := service.NewServices(db, teamRepo, userRepo, prRepo, codeOwnersRepo, strategies)
 
-	h := handlers.NewHandler(service.NewServices(db, teamRepo, userRepo, prRepo))
+	if path := getEnv("CODEOWNERS_FILE", ""); path != "" {
+		repository := getEnv("CODEOWNERS_REPOSITORY", "default")
+		if err := loadCodeOwners(services, repository, path); err != nil {
+			log.Printf("failed to load CODEOWNERS: %v", err)
+			return
+		}
+		log.Printf("loaded CODEOWNERS for repository %s from %s", repository, path)
+	}
+
+	adminToken := getEnv("ADMIN_TOKEN", "")
+	if adminToken == "" {
+		log.Println("ADMIN_TOKEN is not set, force merge is disabled")
+	}
+
+	h := handlers.NewHandler(services, adminToken)
 
 	apiHandler := api.Handler(h)
 
@@ -97,6 +138,21 @@ func (w *loggingResponseWriter) WriteHeader(statusCode int) {
 	w.ResponseWriter.WriteHeader(statusCode)
 }
 
+func loadCodeOwners(services *service.Services, repository, path string) error {
+	content, err := os.ReadFile(path) // #nosec G304 -- path comes from trusted configuration
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := services.CodeOwners.Upload(ctx, repository, string(content)); err != nil {
+		return fmt.Errorf("upload rules for %s: %w", repository, err)
+	}
+	return nil
+}
+
 func getEnv(key, def string) string {
 	if v, ok := os.LookupEnv(key); ok && v != "" {
 		return v
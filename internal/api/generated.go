@@ -12,54 +12,281 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AssignmentRecordAssignedBy.
+const (
+	AssignmentRecordAssignedByBackfill AssignmentRecordAssignedBy = "backfill"
+	AssignmentRecordAssignedByCreate   AssignmentRecordAssignedBy = "create"
+	AssignmentRecordAssignedByReady    AssignmentRecordAssignedBy = "ready"
+	AssignmentRecordAssignedByReassign AssignmentRecordAssignedBy = "reassign"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	FORBIDDEN       ErrorResponseErrorCode = "FORBIDDEN"
+	INVALIDSTATE    ErrorResponseErrorCode = "INVALID_STATE"
+	NOCANDIDATE     ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOCAPACITY      ErrorResponseErrorCode = "NO_CAPACITY"
+	NOTASSIGNED     ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND        ErrorResponseErrorCode = "NOT_FOUND"
+	POLICYNOTMET    ErrorResponseErrorCode = "POLICY_NOT_MET"
+	PREXISTS        ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED        ErrorResponseErrorCode = "PR_MERGED"
+	TEAMARCHIVED    ErrorResponseErrorCode = "TEAM_ARCHIVED"
+	TEAMEXISTS      ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMHASPRS      ErrorResponseErrorCode = "TEAM_HAS_PRS"
+	USERINOTHERTEAM ErrorResponseErrorCode = "USER_IN_OTHER_TEAM"
+)
+
+// Defines values for ExcludedCandidateReason.
+const (
+	AlreadyAssigned ExcludedCandidateReason = "already_assigned"
+	AtCapacity      ExcludedCandidateReason = "at_capacity"
+	Author          ExcludedCandidateReason = "author"
+	Inactive        ExcludedCandidateReason = "inactive"
+	MissingSkills   ExcludedCandidateReason = "missing_skills"
+	Unavailable     ExcludedCandidateReason = "unavailable"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewState.
+const (
+	APPROVED         ReviewState = "APPROVED"
+	CHANGESREQUESTED ReviewState = "CHANGES_REQUESTED"
+	COMMENTED        ReviewState = "COMMENTED"
+)
+
+// Defines values for SkillMatch.
+const (
+	Prefer  SkillMatch = "prefer"
+	Require SkillMatch = "require"
+)
+
+// Defines values for TransferReviews.
+const (
+	TransferReviewsKeep     TransferReviews = "keep"
+	TransferReviewsReassign TransferReviews = "reassign"
+)
+
+// Defines values for ReviewerListSortQuery.
+const (
+	ReviewerListSortQueryCreatedAsc  ReviewerListSortQuery = "created_asc"
+	ReviewerListSortQueryCreatedDesc ReviewerListSortQuery = "created_desc"
+	ReviewerListSortQueryOpenFirst   ReviewerListSortQuery = "open_first"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusCLOSED GetPullRequestListParamsStatus = "CLOSED"
+	GetPullRequestListParamsStatusDRAFT  GetPullRequestListParamsStatus = "DRAFT"
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Defines values for GetPullRequestListParamsSort.
+const (
+	GetPullRequestListParamsSortCreatedAsc  GetPullRequestListParamsSort = "created_asc"
+	GetPullRequestListParamsSortCreatedDesc GetPullRequestListParamsSort = "created_desc"
+)
+
+// Defines values for GetUsersGetAuthoredParamsStatus.
+const (
+	GetUsersGetAuthoredParamsStatusCLOSED GetUsersGetAuthoredParamsStatus = "CLOSED"
+	GetUsersGetAuthoredParamsStatusDRAFT  GetUsersGetAuthoredParamsStatus = "DRAFT"
+	GetUsersGetAuthoredParamsStatusMERGED GetUsersGetAuthoredParamsStatus = "MERGED"
+	GetUsersGetAuthoredParamsStatusOPEN   GetUsersGetAuthoredParamsStatus = "OPEN"
+)
+
+// Defines values for GetUsersGetAuthoredParamsSort.
+const (
+	GetUsersGetAuthoredParamsSortCreatedAsc  GetUsersGetAuthoredParamsSort = "created_asc"
+	GetUsersGetAuthoredParamsSortCreatedDesc GetUsersGetAuthoredParamsSort = "created_desc"
+	GetUsersGetAuthoredParamsSortOpenFirst   GetUsersGetAuthoredParamsSort = "open_first"
+)
+
+// Defines values for GetUsersGetReviewParamsStatus.
+const (
+	CLOSED GetUsersGetReviewParamsStatus = "CLOSED"
+	DRAFT  GetUsersGetReviewParamsStatus = "DRAFT"
+	MERGED GetUsersGetReviewParamsStatus = "MERGED"
+	OPEN   GetUsersGetReviewParamsStatus = "OPEN"
+)
+
+// Defines values for GetUsersGetReviewParamsSort.
+const (
+	GetUsersGetReviewParamsSortCreatedAsc  GetUsersGetReviewParamsSort = "created_asc"
+	GetUsersGetReviewParamsSortCreatedDesc GetUsersGetReviewParamsSort = "created_desc"
+	GetUsersGetReviewParamsSortOpenFirst   GetUsersGetReviewParamsSort = "open_first"
+)
+
+// AssignmentReason Объяснение выбора ревьювера на момент назначения
+type AssignmentReason struct {
+	// Load Число открытых ревью ревьювера до назначения
+	Load int `json:"load"`
+
+	// PoolSize Число допущенных кандидатов в пуле
+	PoolSize int `json:"pool_size"`
+
+	// Rule Шаблон правила CODEOWNERS, по которому пул стал командой-владельцем
+	Rule *string `json:"rule,omitempty"`
+
+	// Strategy Стратегия, выбравшая ревьювера
+	Strategy string `json:"strategy"`
+}
+
+// AssignmentRecord defines model for AssignmentRecord.
+type AssignmentRecord struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// AssignedBy Операция, назначившая ревьювера
+	AssignedBy AssignmentRecordAssignedBy `json:"assigned_by"`
+	Pool       string                     `json:"pool"`
+
+	// Position Позиция в assigned_reviewers; замена занимает позицию заменённого
+	Position int `json:"position"`
+
+	// Reason Объяснение выбора ревьювера на момент назначения
+	Reason     *AssignmentReason `json:"reason,omitempty"`
+	ReplacedAt *time.Time        `json:"replaced_at"`
+
+	// ReplacedBy Ревьювер, заменивший этого
+	ReplacedBy *string `json:"replaced_by"`
+	UserId     string  `json:"user_id"`
+}
+
+// AssignmentRecordAssignedBy Операция, назначившая ревьювера
+type AssignmentRecordAssignedBy string
+
+// AuthoredPullRequest defines model for AuthoredPullRequest.
+type AuthoredPullRequest struct {
+	Pr PullRequest `json:"pr"`
+
+	// WaitingOn Ревьюверы открытого PR без решения APPROVED или CHANGES_REQUESTED, дольше всех ждущие первыми
+	WaitingOn []ReviewerWait `json:"waiting_on"`
+}
+
+// CodeOwnersRule defines model for CodeOwnersRule.
+type CodeOwnersRule struct {
+	// Owners Команды-владельцы путей
+	Owners []string `json:"owners"`
+
+	// Pattern Шаблон пути в синтаксисе CODEOWNERS
+	Pattern string `json:"pattern"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Details Подробности ошибки, например невыполненные условия политики merge
+		Details *[]string `json:"details,omitempty"`
+		Message string    `json:"message"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExcludedCandidate defines model for ExcludedCandidate.
+type ExcludedCandidate struct {
+	Pool   string                  `json:"pool"`
+	Reason ExcludedCandidateReason `json:"reason"`
+	UserId string                  `json:"user_id"`
+}
+
+// ExcludedCandidateReason defines model for ExcludedCandidate.Reason.
+type ExcludedCandidateReason string
+
+// PreviewCandidate defines model for PreviewCandidate.
+type PreviewCandidate struct {
+	MatchedSkills *[]string `json:"matched_skills,omitempty"`
+
+	// OpenReviews Число открытых ревью кандидата
+	OpenReviews int    `json:"open_reviews"`
+	Pool        string `json:"pool"`
+	UserId      string `json:"user_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..reviewers_count команды автора, по умолчанию 2)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ChangedFiles      *[]string  `json:"changed_files,omitempty"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// ForceMerged PR смержен администратором в обход политики merge
+	ForceMerged     *bool      `json:"force_merged,omitempty"`
+	MergedAt        *time.Time `json:"mergedAt"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// Repository Репозиторий, по правилам CODEOWNERS которого назначались ревьюверы
+	Repository *string `json:"repository,omitempty"`
+
+	// RequiredSkills Навыки, которыми должны обладать ревьюверы
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// Reviewers Назначенные ревьюверы с источником назначения, в порядке assigned_reviewers
+	Reviewers *[]ReviewerAssignment `json:"reviewers,omitempty"`
+
+	// SkillMatch prefer — кандидаты с подходящими навыками выбираются в первую очередь;
+	// require — назначаются только кандидаты хотя бы с одним из required_skills.
+	SkillMatch *SkillMatch       `json:"skill_match,omitempty"`
+	Status     PullRequestStatus `json:"status"`
+
+	// UnmetOwners Команды-владельцы по CODEOWNERS, от которых при назначении не нашлось ревьювера; пока список не пуст, PR мержится только с force
+	UnmetOwners *[]string `json:"unmet_owners,omitempty"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestHistory defines model for PullRequestHistory.
+type PullRequestHistory struct {
+	// Assignments Все назначения, включая заменённые, в порядке назначения
+	Assignments []AssignmentRecord `json:"assignments"`
+
+	// Reviews Все решения ревьюверов в порядке отправки
+	Reviews []Review `json:"reviews"`
+}
+
+// PullRequestPreview defines model for PullRequestPreview.
+type PullRequestPreview struct {
+	AuthorId string `json:"author_id"`
+
+	// BlockedReason Причина, по которой создание PR завершилось бы ошибкой NO_CAPACITY
+	BlockedReason *string `json:"blocked_reason,omitempty"`
+
+	// Candidates Все кандидаты просмотренных пулов
+	Candidates []PreviewCandidate `json:"candidates"`
+
+	// Excluded Участники просмотренных пулов, не допущенные к назначению, с причиной
+	Excluded []ExcludedCandidate `json:"excluded"`
+
+	// Reviewers Ревьюверы, которые были бы назначены
+	Reviewers []ReviewerAssignment `json:"reviewers"`
+
+	// UnmetOwners Команды-владельцы, от которых не нашлось ревьювера; PR был бы создан без них
+	UnmetOwners *[]string `json:"unmet_owners,omitempty"`
+}
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -71,10 +298,87 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Review defines model for Review.
+type Review struct {
+	Comment       string `json:"comment"`
+	Id            int64  `json:"id"`
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+
+	// State Решение ревьювера по PR
+	State       ReviewState `json:"state"`
+	SubmittedAt time.Time   `json:"submitted_at"`
+}
+
+// ReviewHandoff defines model for ReviewHandoff.
+type ReviewHandoff struct {
+	// NewReviewerId Новый ревьювер; null, если кандидатов не нашлось и ревьювер просто снят
+	NewReviewerId *string `json:"new_reviewer_id"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// ReviewLoad defines model for ReviewLoad.
+type ReviewLoad struct {
+	// AtCapacity Лимит исчерпан, пользователь не назначается на новые ревью
+	AtCapacity bool `json:"at_capacity"`
+
+	// MaxOpenReviews Действующий лимит (личный или команды); отсутствует, если лимита нет
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// OpenReviews Количество открытых PR, где пользователь назначен ревьювером
+	OpenReviews int    `json:"open_reviews"`
+	UserId      string `json:"user_id"`
+}
+
+// ReviewState Решение ревьювера по PR
+type ReviewState string
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	// AssignedAt Время назначения ревьювера
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+
+	// MatchedSkills Навыки ревьювера из required_skills PR, по которым он выбран
+	MatchedSkills *[]string `json:"matched_skills,omitempty"`
+
+	// Pool Команда, из которой назначен ревьювер (команда автора/заменяемого или резервная)
+	Pool string `json:"pool"`
+
+	// Reason Объяснение выбора ревьювера на момент назначения
+	Reason *AssignmentReason `json:"reason,omitempty"`
+
+	// ReviewState Решение ревьювера по PR
+	ReviewState *ReviewState `json:"review_state,omitempty"`
+
+	// ReviewedAt Время решения из review_state, а не последнего отправленного ревью
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	UserId     string     `json:"user_id"`
+}
+
+// ReviewerWait defines model for ReviewerWait.
+type ReviewerWait struct {
+	// ReviewState Решение ревьювера по PR
+	ReviewState *ReviewState `json:"review_state,omitempty"`
+
+	// Since Время назначения ревьювера
+	Since  time.Time `json:"since"`
+	UserId string    `json:"user_id"`
+
+	// WaitingSeconds Сколько секунд PR ждёт решения ревьювера
+	WaitingSeconds int64 `json:"waiting_seconds"`
+}
+
+// SkillMatch prefer — кандидаты с подходящими навыками выбираются в первую очередь;
+// require — назначаются только кандидаты хотя бы с одним из required_skills.
+type SkillMatch string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// ArchivedAt Время архивации; команда в архиве не участвует в назначении ревьюверов
+	ArchivedAt *time.Time   `json:"archived_at"`
+	Members    []TeamMember `json:"members"`
+	TeamName   string       `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
@@ -84,6 +388,44 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// AssignmentStrategy Стратегия выбора ревьюверов из пула команды (first, random, round_robin, least_loaded, weighted); отсутствует, если используется стратегия сервера ASSIGNMENT_STRATEGY
+	AssignmentStrategy *string `json:"assignment_strategy,omitempty"`
+
+	// BlockOnChangesRequested Запрещать merge, пока решение кого-либо из ревьюверов в текущем назначении — CHANGES_REQUESTED (комментарий его не снимает)
+	BlockOnChangesRequested bool `json:"block_on_changes_requested"`
+
+	// FallbackTeams Команды, из которых по порядку добираются ревьюверы, если в команде не хватает кандидатов
+	FallbackTeams []string `json:"fallback_teams"`
+
+	// MaxOpenReviews Лимит открытых ревью по умолчанию для участников команды; отсутствует, если лимита нет
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// ReassignStrategy Стратегия выбора замены из пула команды при переназначении; отсутствует, если используется стратегия сервера REASSIGN_STRATEGY
+	ReassignStrategy *string `json:"reassign_strategy,omitempty"`
+
+	// RequiredApprovals Сколько одобрений текущих ревьюверов нужно для merge PR авторов команды (по умолчанию 0)
+	RequiredApprovals int `json:"required_approvals"`
+
+	// ReviewersCount Сколько ревьюверов назначается на PR автора из команды (по умолчанию 2)
+	ReviewersCount int    `json:"reviewers_count"`
+	TeamName       string `json:"team_name"`
+}
+
+// TransferReviews reassign — открытые ревью переназначаются в прежней команде (и её резервных), как при удалении из команды;
+// keep — пользователь остаётся ревьювером своих открытых PR.
+type TransferReviews string
+
+// Unavailability defines model for Unavailability.
+type Unavailability struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int64     `json:"id"`
+	Reason   string    `json:"reason"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
 // User defines model for User.
 type User struct {
 	IsActive bool   `json:"is_active"`
@@ -92,21 +434,164 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// UserSkillsRequest defines model for UserSkillsRequest.
+type UserSkillsRequest struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// UserTransfer defines model for UserTransfer.
+type UserTransfer struct {
+	// FromTeam Прежняя команда; null, если пользователь был без команды
+	FromTeam *string `json:"from_team"`
+	Id       int64   `json:"id"`
+
+	// Reassigned Сколько открытых ревью передано другим ревьюверам или снято
+	Reassigned int `json:"reassigned"`
+
+	// Reviews reassign — открытые ревью переназначаются в прежней команде (и её резервных), как при удалении из команды;
+	// keep — пользователь остаётся ревьювером своих открытых PR.
+	Reviews       TransferReviews `json:"reviews"`
+	ToTeam        string          `json:"to_team"`
+	TransferredAt time.Time       `json:"transferred_at"`
+	UserId        string          `json:"user_id"`
+}
+
+// UserUnavailability defines model for UserUnavailability.
+type UserUnavailability struct {
+	// Periods Текущие и будущие периоды отсутствия по возрастанию начала
+	Periods []Unavailability `json:"periods"`
+	UserId  string           `json:"user_id"`
+}
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// RepositoryQuery defines model for RepositoryQuery.
+type RepositoryQuery = string
+
+// ReviewerListSortQuery defines model for ReviewerListSortQuery.
+type ReviewerListSortQuery string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetCodeownersGetParams defines parameters for GetCodeownersGet.
+type GetCodeownersGetParams struct {
+	// Repository Имя репозитория
+	Repository RepositoryQuery `form:"repository" json:"repository"`
+}
+
+// PostCodeownersUploadJSONBody defines parameters for PostCodeownersUpload.
+type PostCodeownersUploadJSONBody struct {
+	// Content Содержимое файла CODEOWNERS
+	Content    string `json:"content"`
+	Repository string `json:"repository"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые пути; от каждой команды-владельца назначается хотя бы один ревьювер, а команды без кандидатов попадают в unmet_owners и блокируют merge без force. Владельцы, команды которых уже не существуют, пропускаются
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// Draft Создать черновик (DRAFT) без ревьюверов; они назначаются при /pullRequest/ready
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// Repository Репозиторий, по правилам CODEOWNERS которого определяются команды-владельцы
+	Repository     *string   `json:"repository,omitempty"`
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// SkillMatch prefer — кандидаты с подходящими навыками выбираются в первую очередь;
+	// require — назначаются только кандидаты хотя бы с одним из required_skills.
+	SkillMatch *SkillMatch `json:"skill_match,omitempty"`
 }
 
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status   *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId *string                         `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// ReviewerId Текущий ревьювер PR
+	ReviewerId *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора PR на момент создания (author_team_name), а не его текущая команда; для архивной команды список пуст
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom createdAt >= created_from
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo createdAt < created_to
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// MergedFrom mergedAt >= merged_from
+	MergedFrom *time.Time `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+
+	// MergedTo mergedAt < merged_to
+	MergedTo *time.Time                    `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+	Sort     *GetPullRequestListParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Limit    *int                          `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
+// GetPullRequestListParamsSort defines parameters for GetPullRequestList.
+type GetPullRequestListParamsSort string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	// Force Смержить в обход политики (только для администратора)
+	Force         *bool  `json:"force,omitempty"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// XAdminToken Токен администратора (ADMIN_TOKEN), нужен для force
+	XAdminToken *string `json:"X-Admin-Token,omitempty"`
+}
+
+// PostPullRequestPreviewJSONBody defines parameters for PostPullRequestPreview.
+type PostPullRequestPreviewJSONBody struct {
+	AuthorId       string    `json:"author_id"`
+	ChangedFiles   *[]string `json:"changed_files,omitempty"`
+	Repository     *string   `json:"repository,omitempty"`
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// SkillMatch prefer — кандидаты с подходящими навыками выбираются в первую очередь;
+	// require — назначаются только кандидаты хотя бы с одним из required_skills.
+	SkillMatch *SkillMatch `json:"skill_match,omitempty"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
@@ -116,77 +601,406 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	Comment       *string `json:"comment,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+	ReviewerId    string  `json:"reviewer_id"`
+
+	// State Решение ревьювера по PR
+	State ReviewState `json:"state"`
+}
+
+// PostTeamAddMembersJSONBody defines parameters for PostTeamAddMembers.
+type PostTeamAddMembersJSONBody struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// PostTeamArchiveJSONBody defines parameters for PostTeamArchive.
+type PostTeamArchiveJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamGetSettingsParams defines parameters for GetTeamGetSettings.
+type GetTeamGetSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamRemoveMembersJSONBody defines parameters for PostTeamRemoveMembers.
+type PostTeamRemoveMembersJSONBody struct {
+	TeamName string   `json:"team_name"`
+	UserIds  []string `json:"user_ids"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostTeamSetSettingsJSONBody defines parameters for PostTeamSetSettings.
+type PostTeamSetSettingsJSONBody struct {
+	// AssignmentStrategy Стратегия выбора ревьюверов из пула команды; пустая строка возвращает стратегию сервера
+	AssignmentStrategy      *string `json:"assignment_strategy,omitempty"`
+	BlockOnChangesRequested *bool   `json:"block_on_changes_requested,omitempty"`
+
+	// FallbackTeams Полностью заменяет список резервных команд
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MaxOpenReviews Лимит открытых ревью по умолчанию для участников; 0 снимает лимит
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// ReassignStrategy Стратегия выбора замены из пула команды; пустая строка возвращает стратегию сервера
+	ReassignStrategy  *string `json:"reassign_strategy,omitempty"`
+	RequiredApprovals *int    `json:"required_approvals,omitempty"`
+	ReviewersCount    *int    `json:"reviewers_count,omitempty"`
+	TeamName          string  `json:"team_name"`
+}
+
+// PostUsersAddUnavailabilityJSONBody defines parameters for PostUsersAddUnavailability.
+type PostUsersAddUnavailabilityJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// GetUsersGetAuthoredParams defines parameters for GetUsersGetAuthored.
+type GetUsersGetAuthoredParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery                      `form:"user_id" json:"user_id"`
+	Status *GetUsersGetAuthoredParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort open_first — сначала открытые PR, внутри группы от новых к старым
+	Sort  *GetUsersGetAuthoredParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Limit *int                           `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetUsersGetAuthoredParamsStatus defines parameters for GetUsersGetAuthored.
+type GetUsersGetAuthoredParamsStatus string
+
+// GetUsersGetAuthoredParamsSort defines parameters for GetUsersGetAuthored.
+type GetUsersGetAuthoredParamsSort string
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery                    `form:"user_id" json:"user_id"`
+	Status *GetUsersGetReviewParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort open_first — сначала открытые PR, внутри группы от новых к старым
+	Sort  *GetUsersGetReviewParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Limit *int                         `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetUsersGetReviewParamsStatus defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsStatus string
+
+// GetUsersGetReviewParamsSort defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsSort string
+
+// GetUsersGetSkillsParams defines parameters for GetUsersGetSkills.
+type GetUsersGetSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersGetUnavailabilityParams defines parameters for GetUsersGetUnavailability.
+type GetUsersGetUnavailabilityParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostUsersRemoveUnavailabilityJSONBody defines parameters for PostUsersRemoveUnavailability.
+type PostUsersRemoveUnavailabilityJSONBody struct {
+	Id     int64  `json:"id"`
+	UserId string `json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	// MaxOpenReviews 0 снимает личный лимит, действует лимит команды
+	MaxOpenReviews int    `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersTransferJSONBody defines parameters for PostUsersTransfer.
+type PostUsersTransferJSONBody struct {
+	// Reviews reassign — открытые ревью переназначаются в прежней команде (и её резервных), как при удалении из команды;
+	// keep — пользователь остаётся ревьювером своих открытых PR.
+	Reviews *TransferReviews `json:"reviews,omitempty"`
+
+	// TeamName Новая команда
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostCodeownersUploadJSONRequestBody defines body for PostCodeownersUpload for application/json ContentType.
+type PostCodeownersUploadJSONRequestBody PostCodeownersUploadJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestPreviewJSONRequestBody defines body for PostPullRequestPreview for application/json ContentType.
+type PostPullRequestPreviewJSONRequestBody PostPullRequestPreviewJSONBody
+
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamAddMembersJSONRequestBody defines body for PostTeamAddMembers for application/json ContentType.
+type PostTeamAddMembersJSONRequestBody PostTeamAddMembersJSONBody
+
+// PostTeamArchiveJSONRequestBody defines body for PostTeamArchive for application/json ContentType.
+type PostTeamArchiveJSONRequestBody PostTeamArchiveJSONBody
+
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
+
+// PostTeamRemoveMembersJSONRequestBody defines body for PostTeamRemoveMembers for application/json ContentType.
+type PostTeamRemoveMembersJSONRequestBody PostTeamRemoveMembersJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSetSettingsJSONRequestBody defines body for PostTeamSetSettings for application/json ContentType.
+type PostTeamSetSettingsJSONRequestBody PostTeamSetSettingsJSONBody
+
+// PostTeamUpdateJSONRequestBody defines body for PostTeamUpdate for application/json ContentType.
+type PostTeamUpdateJSONRequestBody = Team
+
+// PostUsersAddSkillsJSONRequestBody defines body for PostUsersAddSkills for application/json ContentType.
+type PostUsersAddSkillsJSONRequestBody = UserSkillsRequest
+
+// PostUsersAddUnavailabilityJSONRequestBody defines body for PostUsersAddUnavailability for application/json ContentType.
+type PostUsersAddUnavailabilityJSONRequestBody PostUsersAddUnavailabilityJSONBody
+
+// PostUsersRemoveSkillsJSONRequestBody defines body for PostUsersRemoveSkills for application/json ContentType.
+type PostUsersRemoveSkillsJSONRequestBody = UserSkillsRequest
+
+// PostUsersRemoveUnavailabilityJSONRequestBody defines body for PostUsersRemoveUnavailability for application/json ContentType.
+type PostUsersRemoveUnavailabilityJSONRequestBody PostUsersRemoveUnavailabilityJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody = UserSkillsRequest
+
+// PostUsersTransferJSONRequestBody defines body for PostUsersTransfer for application/json ContentType.
+type PostUsersTransferJSONRequestBody PostUsersTransferJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Получить правила CODEOWNERS репозитория
+	// (GET /codeowners/get)
+	GetCodeownersGet(w http.ResponseWriter, r *http.Request, params GetCodeownersGetParams)
+	// Загрузить правила CODEOWNERS репозитория (заменяет текущие)
+	// (POST /codeowners/upload)
+	PostCodeownersUpload(w http.ResponseWriter, r *http.Request)
+	// Закрыть PR без merge
+	// (POST /pullRequest/close)
+	PostPullRequestClose(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (reviewers_count, по умолчанию 2)
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Получить PR с метаданными назначения ревьюверов и историей
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
+	// Список PR с фильтрами и курсорной пагинацией
+	// (GET /pullRequest/list)
+	GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
+	// Предпросмотр назначения ревьюверов без создания PR
+	// (POST /pullRequest/preview)
+	PostPullRequestPreview(w http.ResponseWriter, r *http.Request)
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(w http.ResponseWriter, r *http.Request)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Повторно открыть закрытый PR
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(w http.ResponseWriter, r *http.Request)
+	// Отправить решение ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(w http.ResponseWriter, r *http.Request)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Добавить участников в существующую команду (создаёт/обновляет пользователей)
+	// (POST /team/addMembers)
+	PostTeamAddMembers(w http.ResponseWriter, r *http.Request)
+	// Архивировать команду
+	// (POST /team/archive)
+	PostTeamArchive(w http.ResponseWriter, r *http.Request)
+	// Удалить команду
+	// (POST /team/delete)
+	PostTeamDelete(w http.ResponseWriter, r *http.Request)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Получить настройки назначения ревьюверов команды
+	// (GET /team/getSettings)
+	GetTeamGetSettings(w http.ResponseWriter, r *http.Request, params GetTeamGetSettingsParams)
+	// Удалить участников из команды
+	// (POST /team/removeMembers)
+	PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request)
+	// Обновить настройки назначения ревьюверов команды
+	// (POST /team/setSettings)
+	PostTeamSetSettings(w http.ResponseWriter, r *http.Request)
+	// Заменить состав команды
+	// (POST /team/update)
+	PostTeamUpdate(w http.ResponseWriter, r *http.Request)
+	// Добавить навыки пользователю
+	// (POST /users/addSkills)
+	PostUsersAddSkills(w http.ResponseWriter, r *http.Request)
+	// Добавить период отсутствия пользователя
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request)
+	// Получить PR'ы автора и кого они ждут
+	// (GET /users/getAuthored)
+	GetUsersGetAuthored(w http.ResponseWriter, r *http.Request, params GetUsersGetAuthoredParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Получить навыки пользователя
+	// (GET /users/getSkills)
+	GetUsersGetSkills(w http.ResponseWriter, r *http.Request, params GetUsersGetSkillsParams)
+	// Получить текущие и будущие периоды отсутствия пользователя
+	// (GET /users/getUnavailability)
+	GetUsersGetUnavailability(w http.ResponseWriter, r *http.Request, params GetUsersGetUnavailabilityParams)
+	// Удалить навыки пользователя
+	// (POST /users/removeSkills)
+	PostUsersRemoveSkills(w http.ResponseWriter, r *http.Request)
+	// Удалить период отсутствия пользователя
+	// (POST /users/removeUnavailability)
+	PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Установить личный лимит открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request)
+	// Заменить набор навыков пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(w http.ResponseWriter, r *http.Request)
+	// Перевести пользователя в другую команду
+	// (POST /users/transfer)
+	PostUsersTransfer(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Получить правила CODEOWNERS репозитория
+// (GET /codeowners/get)
+func (_ Unimplemented) GetCodeownersGet(w http.ResponseWriter, r *http.Request, params GetCodeownersGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить правила CODEOWNERS репозитория (заменяет текущие)
+// (POST /codeowners/upload)
+func (_ Unimplemented) PostCodeownersUpload(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрыть PR без merge
+// (POST /pullRequest/close)
+func (_ Unimplemented) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить ревьюверов из команды автора (reviewers_count, по умолчанию 2)
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить PR с метаданными назначения ревьюверов и историей
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список PR с фильтрами и курсорной пагинацией
+// (GET /pullRequest/list)
+func (_ Unimplemented) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Предпросмотр назначения ревьюверов без создания PR
+// (POST /pullRequest/preview)
+func (_ Unimplemented) PostPullRequestPreview(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перевести черновик в OPEN и назначить ревьюверов
+// (POST /pullRequest/ready)
+func (_ Unimplemented) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -196,44 +1010,643 @@ func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// Повторно открыть закрытый PR
+// (POST /pullRequest/reopen)
+func (_ Unimplemented) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+// Отправить решение ревьювера по PR
+// (POST /pullRequest/review)
+func (_ Unimplemented) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить участников в существующую команду (создаёт/обновляет пользователей)
+// (POST /team/addMembers)
+func (_ Unimplemented) PostTeamAddMembers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать команду
+// (POST /team/archive)
+func (_ Unimplemented) PostTeamArchive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду
+// (POST /team/delete)
+func (_ Unimplemented) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки назначения ревьюверов команды
+// (GET /team/getSettings)
+func (_ Unimplemented) GetTeamGetSettings(w http.ResponseWriter, r *http.Request, params GetTeamGetSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить участников из команды
+// (POST /team/removeMembers)
+func (_ Unimplemented) PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить настройки назначения ревьюверов команды
+// (POST /team/setSettings)
+func (_ Unimplemented) PostTeamSetSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить состав команды
+// (POST /team/update)
+func (_ Unimplemented) PostTeamUpdate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить навыки пользователю
+// (POST /users/addSkills)
+func (_ Unimplemented) PostUsersAddSkills(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить период отсутствия пользователя
+// (POST /users/addUnavailability)
+func (_ Unimplemented) PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы автора и кого они ждут
+// (GET /users/getAuthored)
+func (_ Unimplemented) GetUsersGetAuthored(w http.ResponseWriter, r *http.Request, params GetUsersGetAuthoredParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
 func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Получить навыки пользователя
+// (GET /users/getSkills)
+func (_ Unimplemented) GetUsersGetSkills(w http.ResponseWriter, r *http.Request, params GetUsersGetSkillsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить текущие и будущие периоды отсутствия пользователя
+// (GET /users/getUnavailability)
+func (_ Unimplemented) GetUsersGetUnavailability(w http.ResponseWriter, r *http.Request, params GetUsersGetUnavailabilityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить навыки пользователя
+// (POST /users/removeSkills)
+func (_ Unimplemented) PostUsersRemoveSkills(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить период отсутствия пользователя
+// (POST /users/removeUnavailability)
+func (_ Unimplemented) PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить личный лимит открытых ревью пользователя
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить набор навыков пользователя
+// (POST /users/setSkills)
+func (_ Unimplemented) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перевести пользователя в другую команду
+// (POST /users/transfer)
+func (_ Unimplemented) PostUsersTransfer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetCodeownersGet operation middleware
+func (siw *ServerInterfaceWrapper) GetCodeownersGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCodeownersGetParams
+
+	// ------------- Required query parameter "repository" -------------
+
+	if paramValue := r.URL.Query().Get("repository"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCodeownersGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCodeownersUpload operation middleware
+func (siw *ServerInterfaceWrapper) PostCodeownersUpload(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCodeownersUpload(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestClose(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_from", r.URL.Query(), &params.MergedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_to", r.URL.Query(), &params.MergedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestPreview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestPreview(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestPreview(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReady(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReopen(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReview(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAddMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMembers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAddMembers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamArchive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamArchive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGetSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetSettingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGetSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRemoveMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMembers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r)
+	}))
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetSettings(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.PostTeamSetSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -243,11 +1656,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// PostTeamUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamUpdate(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.PostTeamUpdate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -257,11 +1670,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostUsersAddSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddSkills(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostUsersAddSkills(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -271,11 +1684,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostUsersAddUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostUsersAddUnavailability(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -285,31 +1698,63 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetUsersGetAuthored operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetAuthored(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetUsersGetAuthoredParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "user_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetUsersGetAuthored(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -342,6 +1787,38 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetUsersGetReview(w, r, params)
 	}))
@@ -353,6 +1830,102 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// GetUsersGetSkills operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetSkills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetSkillsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetSkills(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetUnavailabilityParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetUnavailability(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersRemoveSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersRemoveSkills(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersRemoveSkills(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersRemoveUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersRemoveUnavailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostUsersSetIsActive operation middleware
 func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 
@@ -367,6 +1940,48 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetSkills(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersTransfer(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersTransfer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -480,27 +2095,108 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/codeowners/get", wrapper.GetCodeownersGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/codeowners/upload", wrapper.PostCodeownersUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/preview", wrapper.PostPullRequestPreview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMembers", wrapper.PostTeamAddMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/archive", wrapper.PostTeamArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getSettings", wrapper.GetTeamGetSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMembers", wrapper.PostTeamRemoveMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setSettings", wrapper.PostTeamSetSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/update", wrapper.PostTeamUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/addSkills", wrapper.PostUsersAddSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/addUnavailability", wrapper.PostUsersAddUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getAuthored", wrapper.GetUsersGetAuthored)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getSkills", wrapper.GetUsersGetSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getUnavailability", wrapper.GetUsersGetUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/removeSkills", wrapper.PostUsersRemoveSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/removeUnavailability", wrapper.PostUsersRemoveUnavailability)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/transfer", wrapper.PostUsersTransfer)
+	})
 
 	return r
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostCodeownersUpload handles uploading CODEOWNERS rules of a repository.
+func (h *Handler) PostCodeownersUpload(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostCodeownersUploadJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostCodeownersUpload decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	if body.Repository == "" {
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "repository is required")
+		return
+	}
+
+	rules, err := h.services.CodeOwners.Upload(r.Context(), body.Repository, body.Content)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidCodeOwners):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		case errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, err.Error())
+		case errors.Is(err, service.ErrTeamArchived):
+			h.writeError(w, http.StatusConflict, api.TEAMARCHIVED, err.Error())
+		default:
+			log.Printf("PostCodeownersUpload internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Repository string               `json:"repository"`
+		Rules      []api.CodeOwnersRule `json:"rules"`
+	}{
+		Repository: body.Repository,
+		Rules:      rules,
+	}); err != nil {
+		log.Printf("PostCodeownersUpload encode error: %v", err)
+	}
+	log.Printf("PostCodeownersUpload success: repository=%s rules=%d duration=%s", body.Repository, len(rules), time.Since(start))
+}
+
+// GetCodeownersGet handles retrieving CODEOWNERS rules of a repository.
+func (h *Handler) GetCodeownersGet(w http.ResponseWriter, r *http.Request, params api.GetCodeownersGetParams) {
+	start := time.Now()
+	repository := string(params.Repository)
+
+	rules, err := h.services.CodeOwners.GetRules(r.Context(), repository)
+	if err != nil {
+		log.Printf("GetCodeownersGet internal error: %v", err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Repository string               `json:"repository"`
+		Rules      []api.CodeOwnersRule `json:"rules"`
+	}{
+		Repository: repository,
+		Rules:      rules,
+	}); err != nil {
+		log.Printf("GetCodeownersGet encode error: %v", err)
+	}
+	log.Printf("GetCodeownersGet success: repository=%s rules=%d duration=%s", repository, len(rules), time.Since(start))
+}
@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"log"
@@ -15,11 +16,13 @@ import (
 // Handler holds dependencies for HTTP handlers.
 type Handler struct {
 	services *service.Services
+	// adminToken authorizes admin-only operations; empty disables them.
+	adminToken string
 }
 
 // NewHandler creates a new Handler instance.
-func NewHandler(services *service.Services) *Handler {
-	return &Handler{services: services}
+func NewHandler(services *service.Services, adminToken string) *Handler {
+	return &Handler{services: services, adminToken: adminToken}
 }
 
 // PostPullRequestCreate handles PR creation.
@@ -40,6 +43,12 @@ func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
 			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "author or team not found")
 		case errors.Is(err, service.ErrPRAlreadyExists):
 			h.writeError(w, http.StatusBadRequest, api.PREXISTS, "pull_request_id already exists")
+		case errors.Is(err, service.ErrNoCandidate):
+			h.writeError(w, http.StatusConflict, api.NOCANDIDATE, err.Error())
+		case errors.Is(err, service.ErrNoCapacity):
+			h.writeError(w, http.StatusConflict, api.NOCAPACITY, err.Error())
+		case errors.Is(err, service.ErrInvalidSkills):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
 		default:
 			log.Printf("PostPullRequestCreate internal error: %v", err)
 			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
@@ -57,8 +66,69 @@ func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
 	log.Printf("PostPullRequestCreate success: author_id=%s pr_id=%s duration=%s", body.AuthorId, body.PullRequestId, time.Since(start))
 }
 
+// PostPullRequestPreview handles previewing reviewer assignment for a PR that is not created yet.
+func (h *Handler) PostPullRequestPreview(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostPullRequestPreviewJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostPullRequestPreview decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	preview, err := h.services.PRs.PreviewPR(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound),
+			errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "author or team not found")
+		case errors.Is(err, service.ErrInvalidSkills):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostPullRequestPreview internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(preview); err != nil {
+		log.Printf("PostPullRequestPreview encode error: %v", err)
+	}
+	log.Printf("PostPullRequestPreview success: author_id=%s reviewers=%d candidates=%d duration=%s",
+		body.AuthorId, len(preview.Reviewers), len(preview.Candidates), time.Since(start))
+}
+
+// GetPullRequestGet handles retrieving a PR with its reviewer assignment metadata.
+func (h *Handler) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params api.GetPullRequestGetParams) {
+	start := time.Now()
+	prID := string(params.PullRequestId)
+
+	pr, history, err := h.services.PRs.GetPR(r.Context(), prID)
+	if err != nil {
+		if errors.Is(err, service.ErrPRNotFound) {
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "pull request not found")
+			return
+		}
+		log.Printf("GetPullRequestGet internal error: %v", err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Pr      *api.PullRequest        `json:"pr"`
+		History *api.PullRequestHistory `json:"history"`
+	}{Pr: pr, History: history}); err != nil {
+		log.Printf("GetPullRequestGet encode error: %v", err)
+	}
+	log.Printf("GetPullRequestGet success: pr_id=%s assignments=%d reviews=%d duration=%s", prID, len(history.Assignments), len(history.Reviews), time.Since(start))
+}
+
 // PostPullRequestMerge handles PR merging.
-func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params api.PostPullRequestMergeParams) {
 	start := time.Now()
 	var body api.PostPullRequestMergeJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -67,11 +137,22 @@ func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pr, err := h.services.PRs.MergePR(r.Context(), body.PullRequestId)
+	force := body.Force != nil && *body.Force
+	if force && !h.isAdmin(params.XAdminToken) {
+		h.writeError(w, http.StatusForbidden, api.FORBIDDEN, "force merge requires a valid admin token")
+		return
+	}
+
+	pr, err := h.services.PRs.MergePR(r.Context(), body.PullRequestId, force)
 	if err != nil {
+		var policyErr *service.PolicyNotMetError
 		switch {
 		case errors.Is(err, service.ErrPRNotFound):
 			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "pull request not found")
+		case errors.Is(err, service.ErrInvalidState):
+			h.writeError(w, http.StatusConflict, api.INVALIDSTATE, err.Error())
+		case errors.As(err, &policyErr):
+			h.writeErrorDetails(w, http.StatusConflict, api.POLICYNOTMET, "merge policy not met", policyErr.Missing)
 		default:
 			log.Printf("PostPullRequestMerge internal error: %v", err)
 			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
@@ -86,7 +167,7 @@ func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	}{Pr: pr}); err != nil {
 		log.Printf("PostPullRequestMerge encode error: %v", err)
 	}
-	log.Printf("PostPullRequestMerge success: pr_id=%s duration=%s", body.PullRequestId, time.Since(start))
+	log.Printf("PostPullRequestMerge success: pr_id=%s force=%t duration=%s", body.PullRequestId, force, time.Since(start))
 }
 
 // PostPullRequestReassign handles reviewer reassignment.
@@ -99,17 +180,21 @@ func (h *Handler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	pr, replacedBy, err := h.services.PRs.ReassignReviewer(r.Context(), &body)
+	res, err := h.services.PRs.ReassignReviewer(r.Context(), &body)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrPRNotFound):
 			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "pull request not found")
 		case errors.Is(err, service.ErrPRMerged):
 			h.writeError(w, http.StatusConflict, api.PRMERGED, "pull request is already merged")
+		case errors.Is(err, service.ErrInvalidState):
+			h.writeError(w, http.StatusConflict, api.INVALIDSTATE, err.Error())
 		case errors.Is(err, service.ErrReviewerNotAssigned):
 			h.writeError(w, http.StatusConflict, api.NOTASSIGNED, "user is not assigned as reviewer")
 		case errors.Is(err, service.ErrNoCandidate):
 			h.writeError(w, http.StatusConflict, api.NOCANDIDATE, "no candidate for reassignment")
+		case errors.Is(err, service.ErrNoCapacity):
+			h.writeError(w, http.StatusConflict, api.NOCAPACITY, "all candidates are at review capacity")
 		default:
 			log.Printf("PostPullRequestReassign internal error: %v", err)
 			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
@@ -122,13 +207,15 @@ func (h *Handler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request
 	if err := json.NewEncoder(w).Encode(struct {
 		Pr         *api.PullRequest `json:"pr"`
 		ReplacedBy string           `json:"replaced_by"`
+		Candidates []string         `json:"candidates"`
 	}{
-		Pr:         pr,
-		ReplacedBy: replacedBy,
+		Pr:         res.PR,
+		ReplacedBy: res.ReplacedBy,
+		Candidates: res.Candidates,
 	}); err != nil {
 		log.Printf("PostPullRequestReassign encode error: %v", err)
 	}
-	log.Printf("PostPullRequestReassign success: pr_id=%s old_user=%s new_user=%s duration=%s", body.PullRequestId, body.OldUserId, replacedBy, time.Since(start))
+	log.Printf("PostPullRequestReassign success: pr_id=%s old_user=%s new_user=%s candidates=%d duration=%s", body.PullRequestId, body.OldUserId, res.ReplacedBy, len(res.Candidates), time.Since(start))
 }
 
 // PostTeamAdd handles team creation.
@@ -151,6 +238,8 @@ func (h *Handler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 		case errors.Is(err, service.ErrTeamAlreadyExists):
 			log.Printf("PostTeamAdd error: %v", err)
 			h.writeError(w, http.StatusBadRequest, api.TEAMEXISTS, "team_name already exists")
+		case errors.Is(err, service.ErrUserInOtherTeam):
+			h.writeError(w, http.StatusConflict, api.USERINOTHERTEAM, err.Error())
 		default:
 			log.Printf("PostTeamAdd internal error: %v", err)
 			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
@@ -195,12 +284,73 @@ func (h *Handler) GetTeamGet(w http.ResponseWriter, r *http.Request, params api.
 	log.Printf("GetTeamGet success: team_name=%s members=%d duration=%s", teamName, len(team.Members), time.Since(start))
 }
 
+// GetTeamGetSettings handles retrieving team settings.
+func (h *Handler) GetTeamGetSettings(w http.ResponseWriter, r *http.Request, params api.GetTeamGetSettingsParams) {
+	start := time.Now()
+	teamName := string(params.TeamName)
+
+	settings, err := h.services.Teams.GetSettings(r.Context(), teamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "team not found")
+		default:
+			log.Printf("GetTeamGetSettings internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Settings *api.TeamSettings `json:"settings"`
+	}{Settings: settings}); err != nil {
+		log.Printf("GetTeamGetSettings encode error: %v", err)
+	}
+	log.Printf("GetTeamGetSettings success: team_name=%s duration=%s", teamName, time.Since(start))
+}
+
+// PostTeamSetSettings handles updating team settings.
+func (h *Handler) PostTeamSetSettings(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostTeamSetSettingsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostTeamSetSettings decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	settings, err := h.services.Teams.UpdateSettings(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "team not found")
+		case errors.Is(err, service.ErrInvalidTeamSettings):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostTeamSetSettings internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Settings *api.TeamSettings `json:"settings"`
+	}{Settings: settings}); err != nil {
+		log.Printf("PostTeamSetSettings encode error: %v", err)
+	}
+	log.Printf("PostTeamSetSettings success: team_name=%s reviewers_count=%d duration=%s", settings.TeamName, settings.ReviewersCount, time.Since(start))
+}
+
 // GetUsersGetReview handles retrieving PRs for review by a user.
 func (h *Handler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params api.GetUsersGetReviewParams) {
 	start := time.Now()
 	userID := string(params.UserId)
 
-	prs, err := h.services.Users.GetReviewPullRequests(r.Context(), userID)
+	load, err := h.services.Users.GetReviewLoad(r.Context(), userID)
 	if err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
@@ -211,18 +361,70 @@ func (h *Handler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, para
 		return
 	}
 
+	page, err := h.services.Users.GetReviewPullRequests(r.Context(), &params)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidListParams) {
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+			return
+		}
+		log.Printf("GetUsersGetReview internal error: %v", err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(struct {
 		UserID       string                 `json:"user_id"`
 		PullRequests []api.PullRequestShort `json:"pull_requests"`
+		Load         *api.ReviewLoad        `json:"load"`
+		PendingCount int                    `json:"pending_count"`
+		NextCursor   *string                `json:"next_cursor"`
 	}{
 		UserID:       userID,
-		PullRequests: toShorts(prs),
+		PullRequests: toShorts(page.PullRequests),
+		Load:         load,
+		PendingCount: page.PendingCount,
+		NextCursor:   page.NextCursor,
 	}); err != nil {
 		log.Printf("GetUsersGetReview encode error: %v", err)
 	}
-	log.Printf("GetUsersGetReview success: user_id=%s prs=%d duration=%s", userID, len(prs), time.Since(start))
+	log.Printf("GetUsersGetReview success: user_id=%s prs=%d pending=%d duration=%s", userID, len(page.PullRequests), page.PendingCount, time.Since(start))
+}
+
+// GetUsersGetAuthored handles retrieving PRs authored by a user with the reviewers they wait on.
+func (h *Handler) GetUsersGetAuthored(w http.ResponseWriter, r *http.Request, params api.GetUsersGetAuthoredParams) {
+	start := time.Now()
+	userID := string(params.UserId)
+
+	page, err := h.services.Users.GetAuthoredPullRequests(r.Context(), &params)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrInvalidListParams):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("GetUsersGetAuthored internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		UserID       string                    `json:"user_id"`
+		PullRequests []api.AuthoredPullRequest `json:"pull_requests"`
+		NextCursor   *string                   `json:"next_cursor"`
+	}{
+		UserID:       userID,
+		PullRequests: page.PullRequests,
+		NextCursor:   page.NextCursor,
+	}); err != nil {
+		log.Printf("GetUsersGetAuthored encode error: %v", err)
+	}
+	log.Printf("GetUsersGetAuthored success: user_id=%s prs=%d duration=%s", userID, len(page.PullRequests), time.Since(start))
 }
 
 // PostUsersSetIsActive handles setting user active status.
@@ -257,6 +459,40 @@ func (h *Handler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	log.Printf("PostUsersSetIsActive success: user_id=%s is_active=%t duration=%s", body.UserId, body.IsActive, time.Since(start))
 }
 
+// PostUsersSetMaxOpenReviews handles setting a user's open review limit.
+func (h *Handler) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostUsersSetMaxOpenReviewsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersSetMaxOpenReviews decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	load, err := h.services.Users.SetMaxOpenReviews(r.Context(), body.UserId, body.MaxOpenReviews)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrInvalidReviewLimit):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostUsersSetMaxOpenReviews internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Load *api.ReviewLoad `json:"load"`
+	}{Load: load}); err != nil {
+		log.Printf("PostUsersSetMaxOpenReviews encode error: %v", err)
+	}
+	log.Printf("PostUsersSetMaxOpenReviews success: user_id=%s max_open_reviews=%d duration=%s", body.UserId, body.MaxOpenReviews, time.Since(start))
+}
+
 // GetStats handles retrieving system statistics.
 func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
 	start := time.Now()
@@ -276,17 +512,18 @@ func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) writeError(w http.ResponseWriter, status int, code api.ErrorResponseErrorCode, message string) {
+	h.writeErrorDetails(w, status, code, message, nil)
+}
+
+func (h *Handler) writeErrorDetails(w http.ResponseWriter, status int, code api.ErrorResponseErrorCode, message string, details []string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 
-	resp := api.ErrorResponse{
-		Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{
-			Code:    code,
-			Message: message,
-		},
+	resp := api.ErrorResponse{}
+	resp.Error.Code = code
+	resp.Error.Message = message
+	if len(details) > 0 {
+		resp.Error.Details = &details
 	}
 
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -294,6 +531,14 @@ func (h *Handler) writeError(w http.ResponseWriter, status int, code api.ErrorRe
 	}
 }
 
+// isAdmin reports whether token matches the configured admin token.
+func (h *Handler) isAdmin(token *string) bool {
+	if h.adminToken == "" || token == nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(*token), []byte(h.adminToken)) == 1
+}
+
 func toShorts(prs []*api.PullRequest) []api.PullRequestShort {
 	prshs := []api.PullRequestShort{}
 	for _, pr := range prs {
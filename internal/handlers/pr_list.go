@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// GetPullRequestList handles listing PRs with filters and cursor pagination.
+func (h *Handler) GetPullRequestList(w http.ResponseWriter, r *http.Request, params api.GetPullRequestListParams) {
+	start := time.Now()
+
+	page, err := h.services.PRs.ListPRs(r.Context(), &params)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidListParams) {
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+			return
+		}
+		log.Printf("GetPullRequestList internal error: %v", err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		PullRequests []*api.PullRequest `json:"pull_requests"`
+		NextCursor   *string            `json:"next_cursor"`
+	}{
+		PullRequests: page.PullRequests,
+		NextCursor:   page.NextCursor,
+	}); err != nil {
+		log.Printf("GetPullRequestList encode error: %v", err)
+	}
+	log.Printf("GetPullRequestList success: count=%d has_next=%t duration=%s", len(page.PullRequests), page.NextCursor != nil, time.Since(start))
+}
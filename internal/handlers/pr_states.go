@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostPullRequestReady handles moving a draft PR to OPEN.
+func (h *Handler) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	var body api.PostPullRequestReadyJSONBody
+	h.changePRStatus(w, r, "PostPullRequestReady", &body, &body.PullRequestId, h.services.PRs.ReadyPR)
+}
+
+// PostPullRequestClose handles closing a PR without merge.
+func (h *Handler) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	var body api.PostPullRequestCloseJSONBody
+	h.changePRStatus(w, r, "PostPullRequestClose", &body, &body.PullRequestId, h.services.PRs.ClosePR)
+}
+
+// PostPullRequestReopen handles reopening a closed PR.
+func (h *Handler) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	var body api.PostPullRequestReopenJSONBody
+	h.changePRStatus(w, r, "PostPullRequestReopen", &body, &body.PullRequestId, h.services.PRs.ReopenPR)
+}
+
+// changePRStatus decodes body, whose PR id is prID, and applies the status change.
+func (h *Handler) changePRStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	op string,
+	body any,
+	prID *string,
+	change func(ctx context.Context, prID string) (*api.PullRequest, error),
+) {
+	start := time.Now()
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		log.Printf("%s decode error: %v", op, err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	pr, err := change(r.Context(), *prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPRNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "pull request not found")
+		case errors.Is(err, service.ErrUserNotFound),
+			errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "author or team not found")
+		case errors.Is(err, service.ErrPRMerged):
+			h.writeError(w, http.StatusConflict, api.PRMERGED, "pull request is already merged")
+		case errors.Is(err, service.ErrInvalidState):
+			h.writeError(w, http.StatusConflict, api.INVALIDSTATE, err.Error())
+		case errors.Is(err, service.ErrNoCandidate):
+			h.writeError(w, http.StatusConflict, api.NOCANDIDATE, err.Error())
+		case errors.Is(err, service.ErrNoCapacity):
+			h.writeError(w, http.StatusConflict, api.NOCAPACITY, err.Error())
+		default:
+			log.Printf("%s internal error: %v", op, err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Pr *api.PullRequest `json:"pr"`
+	}{Pr: pr}); err != nil {
+		log.Printf("%s encode error: %v", op, err)
+	}
+	log.Printf("%s success: pr_id=%s status=%s duration=%s", op, pr.PullRequestId, pr.Status, time.Since(start))
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostPullRequestReview handles submitting a reviewer decision.
+func (h *Handler) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostPullRequestReviewJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostPullRequestReview decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	pr, review, err := h.services.PRs.SubmitReview(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidReviewState):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		case errors.Is(err, service.ErrPRNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "pull request not found")
+		case errors.Is(err, service.ErrPRMerged):
+			h.writeError(w, http.StatusConflict, api.PRMERGED, "pull request is already merged")
+		case errors.Is(err, service.ErrInvalidState):
+			h.writeError(w, http.StatusConflict, api.INVALIDSTATE, err.Error())
+		case errors.Is(err, service.ErrReviewerNotAssigned):
+			h.writeError(w, http.StatusConflict, api.NOTASSIGNED, "user is not assigned as reviewer")
+		default:
+			log.Printf("PostPullRequestReview internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Pr     *api.PullRequest `json:"pr"`
+		Review *api.Review      `json:"review"`
+	}{Pr: pr, Review: review}); err != nil {
+		log.Printf("PostPullRequestReview encode error: %v", err)
+	}
+	log.Printf("PostPullRequestReview success: pr_id=%s reviewer=%s state=%s duration=%s", body.PullRequestId, body.ReviewerId, body.State, time.Since(start))
+}
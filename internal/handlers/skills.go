@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// GetUsersGetSkills handles retrieving user skills.
+func (h *Handler) GetUsersGetSkills(w http.ResponseWriter, r *http.Request, params api.GetUsersGetSkillsParams) {
+	start := time.Now()
+	userID := string(params.UserId)
+
+	skills, err := h.services.Users.GetSkills(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+			return
+		}
+		log.Printf("GetUsersGetSkills internal error: %v", err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		return
+	}
+
+	h.writeSkills(w, "GetUsersGetSkills", skills)
+	log.Printf("GetUsersGetSkills success: user_id=%s skills=%d duration=%s", userID, len(skills.Skills), time.Since(start))
+}
+
+// PostUsersAddSkills handles adding skills to a user.
+func (h *Handler) PostUsersAddSkills(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.UserSkillsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersAddSkills decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	skills, err := h.services.Users.AddSkills(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrInvalidSkills):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostUsersAddSkills internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	h.writeSkills(w, "PostUsersAddSkills", skills)
+	log.Printf("PostUsersAddSkills success: user_id=%s skills=%d duration=%s", body.UserId, len(skills.Skills), time.Since(start))
+}
+
+// PostUsersRemoveSkills handles removing skills from a user.
+func (h *Handler) PostUsersRemoveSkills(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.UserSkillsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersRemoveSkills decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	skills, err := h.services.Users.RemoveSkills(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrInvalidSkills):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostUsersRemoveSkills internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	h.writeSkills(w, "PostUsersRemoveSkills", skills)
+	log.Printf("PostUsersRemoveSkills success: user_id=%s skills=%d duration=%s", body.UserId, len(skills.Skills), time.Since(start))
+}
+
+// PostUsersSetSkills handles replacing user skills.
+func (h *Handler) PostUsersSetSkills(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.UserSkillsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersSetSkills decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	skills, err := h.services.Users.SetSkills(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrInvalidSkills):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostUsersSetSkills internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	h.writeSkills(w, "PostUsersSetSkills", skills)
+	log.Printf("PostUsersSetSkills success: user_id=%s skills=%d duration=%s", body.UserId, len(skills.Skills), time.Since(start))
+}
+
+func (h *Handler) writeSkills(w http.ResponseWriter, op string, skills *api.UserSkills) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(skills); err != nil {
+		log.Printf("%s encode error: %v", op, err)
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostTeamArchive handles archiving a team.
+func (h *Handler) PostTeamArchive(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostTeamArchiveJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostTeamArchive decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	res, err := h.services.Teams.ArchiveTeam(r.Context(), body.TeamName)
+	if err != nil {
+		h.writeMembershipError(w, "PostTeamArchive", err)
+		return
+	}
+
+	h.writeMembershipResult(w, "PostTeamArchive", res)
+	log.Printf("PostTeamArchive success: team_name=%s members=%d reassigned=%d duration=%s", body.TeamName, len(res.Team.Members), len(res.Reassigned), time.Since(start))
+}
+
+// PostTeamDelete handles deleting a team without PRs.
+func (h *Handler) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostTeamDeleteJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostTeamDelete decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	res, err := h.services.Teams.DeleteTeam(r.Context(), body.TeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTeamHasPRs):
+			h.writeError(w, http.StatusConflict, api.TEAMHASPRS, err.Error())
+		default:
+			h.writeMembershipError(w, "PostTeamDelete", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		TeamName       string              `json:"team_name"`
+		RemovedMembers []string            `json:"removed_members"`
+		Reassigned     []api.ReviewHandoff `json:"reassigned"`
+	}{
+		TeamName:       res.TeamName,
+		RemovedMembers: res.RemovedMembers,
+		Reassigned:     res.Reassigned,
+	}); err != nil {
+		log.Printf("PostTeamDelete encode error: %v", err)
+	}
+	log.Printf("PostTeamDelete success: team_name=%s removed=%d reassigned=%d duration=%s", res.TeamName, len(res.RemovedMembers), len(res.Reassigned), time.Since(start))
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostTeamAddMembers handles adding members to an existing team.
+func (h *Handler) PostTeamAddMembers(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostTeamAddMembersJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostTeamAddMembers decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	team, err := h.services.Teams.AddMembers(r.Context(), &body)
+	if err != nil {
+		h.writeMembershipError(w, "PostTeamAddMembers", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Team *api.Team `json:"team"`
+	}{Team: team}); err != nil {
+		log.Printf("PostTeamAddMembers encode error: %v", err)
+	}
+	log.Printf("PostTeamAddMembers success: team_name=%s added=%d duration=%s", body.TeamName, len(body.Members), time.Since(start))
+}
+
+// PostTeamRemoveMembers handles removing members from a team.
+func (h *Handler) PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostTeamRemoveMembersJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostTeamRemoveMembers decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	res, err := h.services.Teams.RemoveMembers(r.Context(), &body)
+	if err != nil {
+		h.writeMembershipError(w, "PostTeamRemoveMembers", err)
+		return
+	}
+
+	h.writeMembershipResult(w, "PostTeamRemoveMembers", res)
+	log.Printf("PostTeamRemoveMembers success: team_name=%s removed=%d reassigned=%d duration=%s", body.TeamName, len(body.UserIds), len(res.Reassigned), time.Since(start))
+}
+
+// PostTeamUpdate handles replacing the members of a team.
+func (h *Handler) PostTeamUpdate(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var team api.Team
+	if err := json.NewDecoder(r.Body).Decode(&team); err != nil {
+		log.Printf("PostTeamUpdate decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	res, err := h.services.Teams.UpdateTeam(r.Context(), &team)
+	if err != nil {
+		h.writeMembershipError(w, "PostTeamUpdate", err)
+		return
+	}
+
+	h.writeMembershipResult(w, "PostTeamUpdate", res)
+	log.Printf("PostTeamUpdate success: team_name=%s members=%d reassigned=%d duration=%s", team.TeamName, len(res.Team.Members), len(res.Reassigned), time.Since(start))
+}
+
+func (h *Handler) writeMembershipError(w http.ResponseWriter, op string, err error) {
+	switch {
+	case errors.Is(err, service.ErrTeamNotFound):
+		h.writeError(w, http.StatusNotFound, api.NOTFOUND, "team not found")
+	case errors.Is(err, service.ErrNotTeamMember):
+		h.writeError(w, http.StatusNotFound, api.NOTFOUND, err.Error())
+	case errors.Is(err, service.ErrUserInOtherTeam):
+		h.writeError(w, http.StatusConflict, api.USERINOTHERTEAM, err.Error())
+	case errors.Is(err, service.ErrTeamArchived):
+		h.writeError(w, http.StatusConflict, api.TEAMARCHIVED, err.Error())
+	default:
+		log.Printf("%s internal error: %v", op, err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+	}
+}
+
+func (h *Handler) writeMembershipResult(w http.ResponseWriter, op string, res *service.MembershipResult) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Team       *api.Team           `json:"team"`
+		Reassigned []api.ReviewHandoff `json:"reassigned"`
+	}{
+		Team:       res.Team,
+		Reassigned: res.Reassigned,
+	}); err != nil {
+		log.Printf("%s encode error: %v", op, err)
+	}
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostTeamRename handles renaming a team.
+func (h *Handler) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostTeamRenameJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostTeamRename decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	team, err := h.services.Teams.RenameTeam(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTeamName):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		case errors.Is(err, service.ErrTeamAlreadyExists):
+			h.writeError(w, http.StatusBadRequest, api.TEAMEXISTS, "new_team_name already exists")
+		case errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "team not found")
+		default:
+			log.Printf("PostTeamRename internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		Team *api.Team `json:"team"`
+	}{Team: team}); err != nil {
+		log.Printf("PostTeamRename encode error: %v", err)
+	}
+	log.Printf("PostTeamRename success: team_name=%s new_team_name=%s duration=%s", body.TeamName, body.NewTeamName, time.Since(start))
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostUsersAddUnavailability handles adding an unavailability period to a user.
+func (h *Handler) PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostUsersAddUnavailabilityJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersAddUnavailability decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	periods, err := h.services.Users.AddUnavailability(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrInvalidUnavailability):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		default:
+			log.Printf("PostUsersAddUnavailability internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	h.writeUnavailability(w, "PostUsersAddUnavailability", http.StatusCreated, periods)
+	log.Printf("PostUsersAddUnavailability success: user_id=%s periods=%d duration=%s", body.UserId, len(periods.Periods), time.Since(start))
+}
+
+// GetUsersGetUnavailability handles retrieving current and upcoming unavailability periods of a user.
+func (h *Handler) GetUsersGetUnavailability(w http.ResponseWriter, r *http.Request, params api.GetUsersGetUnavailabilityParams) {
+	start := time.Now()
+	userID := string(params.UserId)
+
+	periods, err := h.services.Users.GetUnavailability(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+			return
+		}
+		log.Printf("GetUsersGetUnavailability internal error: %v", err)
+		h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		return
+	}
+
+	h.writeUnavailability(w, "GetUsersGetUnavailability", http.StatusOK, periods)
+	log.Printf("GetUsersGetUnavailability success: user_id=%s periods=%d duration=%s", userID, len(periods.Periods), time.Since(start))
+}
+
+// PostUsersRemoveUnavailability handles removing an unavailability period of a user.
+func (h *Handler) PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostUsersRemoveUnavailabilityJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersRemoveUnavailability decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	periods, err := h.services.Users.RemoveUnavailability(r.Context(), body.UserId, body.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnavailabilityNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "unavailability not found")
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		default:
+			log.Printf("PostUsersRemoveUnavailability internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	h.writeUnavailability(w, "PostUsersRemoveUnavailability", http.StatusOK, periods)
+	log.Printf("PostUsersRemoveUnavailability success: user_id=%s id=%d duration=%s", body.UserId, body.Id, time.Since(start))
+}
+
+func (h *Handler) writeUnavailability(w http.ResponseWriter, op string, status int, periods *api.UserUnavailability) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(periods); err != nil {
+		log.Printf("%s encode error: %v", op, err)
+	}
+}
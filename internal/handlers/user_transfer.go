@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/service"
+)
+
+// PostUsersTransfer handles moving a user to another team.
+func (h *Handler) PostUsersTransfer(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	var body api.PostUsersTransferJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.Printf("PostUsersTransfer decode error: %v", err)
+		h.writeError(w, http.StatusBadRequest, api.NOTFOUND, "invalid request body")
+		return
+	}
+
+	res, err := h.services.Teams.TransferUser(r.Context(), &body)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTransfer):
+			h.writeError(w, http.StatusBadRequest, api.NOTFOUND, err.Error())
+		case errors.Is(err, service.ErrUserNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "user not found")
+		case errors.Is(err, service.ErrTeamNotFound):
+			h.writeError(w, http.StatusNotFound, api.NOTFOUND, "team not found")
+		case errors.Is(err, service.ErrTeamArchived):
+			h.writeError(w, http.StatusConflict, api.TEAMARCHIVED, err.Error())
+		default:
+			log.Printf("PostUsersTransfer internal error: %v", err)
+			h.writeError(w, http.StatusInternalServerError, api.NOTFOUND, "internal error")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(struct {
+		User       *api.User           `json:"user"`
+		Transfer   *api.UserTransfer   `json:"transfer"`
+		Reassigned []api.ReviewHandoff `json:"reassigned"`
+	}{
+		User:       res.User,
+		Transfer:   res.Transfer,
+		Reassigned: res.Reassigned,
+	}); err != nil {
+		log.Printf("PostUsersTransfer encode error: %v", err)
+	}
+	log.Printf("PostUsersTransfer success: user_id=%s to=%s reviews=%s reassigned=%d duration=%s", body.UserId, body.TeamName, res.Transfer.Reviews, len(res.Reassigned), time.Since(start))
+}
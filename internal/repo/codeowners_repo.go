@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+
+	"github.com/lib/pq"
+)
+
+// CodeOwnersRepo manages CODEOWNERS rules stored per repository.
+type CodeOwnersRepo struct {
+	db *sql.DB
+}
+
+// NewCodeOwnersRepo creates a new CodeOwnersRepo.
+func NewCodeOwnersRepo(db *sql.DB) *CodeOwnersRepo {
+	return &CodeOwnersRepo{db: db}
+}
+
+// ReplaceRulesTx replaces all rules of a repository within a transaction.
+func (r *CodeOwnersRepo) ReplaceRulesTx(ctx context.Context, tx *sql.Tx, repository string, rules []api.CodeOwnersRule) error {
+	const deleteQuery = `
+        DELETE FROM codeowners_rules WHERE repository = $1
+    `
+	if _, err := tx.ExecContext(ctx, deleteQuery, repository); err != nil {
+		return fmt.Errorf("delete codeowners rules repository=%s: %w", repository, err)
+	}
+
+	const insertQuery = `
+        INSERT INTO codeowners_rules (repository, position, pattern, owners)
+        VALUES ($1, $2, $3, $4)
+    `
+	for i, rule := range rules {
+		_, err := tx.ExecContext(ctx, insertQuery, repository, i, rule.Pattern, pq.Array(rule.Owners))
+		if err != nil {
+			return fmt.Errorf("insert codeowners rule repository=%s pattern=%s: %w", repository, rule.Pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveOwnerTx removes the team from the owners of every rule.
+// Rules left without owners stay, as unowned paths in CODEOWNERS do.
+func (r *CodeOwnersRepo) RemoveOwnerTx(ctx context.Context, tx *sql.Tx, team string) error {
+	const query = `
+        UPDATE codeowners_rules
+        SET owners = array_remove(owners, $1)
+        WHERE $1 = ANY (owners)
+    `
+	if _, err := tx.ExecContext(ctx, query, team); err != nil {
+		return fmt.Errorf("remove codeowners owner team=%s: %w", team, err)
+	}
+	return nil
+}
+
+// RenameOwnerTx replaces the team with its new name in the owners of every rule.
+// A rule that already lists the new name just loses the old one.
+func (r *CodeOwnersRepo) RenameOwnerTx(ctx context.Context, tx *sql.Tx, team, newName string) error {
+	const query = `
+        UPDATE codeowners_rules
+        SET owners = CASE
+                WHEN $2 = ANY (owners) THEN array_remove(owners, $1)
+                ELSE array_replace(owners, $1, $2)
+            END
+        WHERE $1 = ANY (owners)
+    `
+	if _, err := tx.ExecContext(ctx, query, team, newName); err != nil {
+		return fmt.Errorf("rename codeowners owner team=%s to %s: %w", team, newName, err)
+	}
+	return nil
+}
+
+// GetRules returns the rules of a repository in file order.
+func (r *CodeOwnersRepo) GetRules(ctx context.Context, repository string) ([]api.CodeOwnersRule, error) {
+	return getRules(ctx, r.db, repository)
+}
+
+// GetRulesTx returns the rules of a repository in file order within a transaction.
+func (r *CodeOwnersRepo) GetRulesTx(ctx context.Context, tx *sql.Tx, repository string) ([]api.CodeOwnersRule, error) {
+	return getRules(ctx, tx, repository)
+}
+
+func getRules(ctx context.Context, q queryer, repository string) ([]api.CodeOwnersRule, error) {
+	const query = `
+        SELECT pattern, owners
+        FROM codeowners_rules
+        WHERE repository = $1
+        ORDER BY position
+    `
+
+	rows, err := q.QueryContext(ctx, query, repository)
+	if err != nil {
+		return nil, fmt.Errorf("get codeowners rules repository=%s failed: %w", repository, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	rules := []api.CodeOwnersRule{}
+	for rows.Next() {
+		var rule api.CodeOwnersRule
+		if err := rows.Scan(&rule.Pattern, pq.Array(&rule.Owners)); err != nil {
+			return nil, fmt.Errorf("scan codeowners rule repository=%s: %w", repository, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return rules, nil
+}
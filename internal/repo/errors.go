@@ -3,6 +3,8 @@ package repo
 
 import (
 	"errors"
+
+	"github.com/lib/pq"
 )
 
 var (
@@ -10,6 +12,8 @@ var (
 	ErrPRExists = errors.New("pr already exists")
 	// ErrPRNotFound indicates that the requested PR was not found.
 	ErrPRNotFound = errors.New("pr not found")
+	// ErrReviewerNotAssigned indicates that the user is not a current reviewer of the PR.
+	ErrReviewerNotAssigned = errors.New("reviewer not assigned")
 
 	// ErrTeamNotFound indicates that the requested team was not found.
 	ErrTeamNotFound = errors.New("team not found")
@@ -18,4 +22,20 @@ var (
 
 	// ErrUserNotFound indicates that the requested user was not found.
 	ErrUserNotFound = errors.New("user not found")
+	// ErrUserInOtherTeam indicates that the user already belongs to another team.
+	ErrUserInOtherTeam = errors.New("user is in another team")
+	// ErrUnavailabilityNotFound indicates that the requested unavailability period was not found.
+	ErrUnavailabilityNotFound = errors.New("unavailability not found")
 )
+
+// isForeignKeyViolation reports whether err is a PostgreSQL foreign key violation.
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique violation.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
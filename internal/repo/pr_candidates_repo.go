@@ -0,0 +1,126 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PoolMember is a member of a reviewer pool with everything reviewer selection needs.
+type PoolMember struct {
+	UserID   string
+	TeamName string
+	IsActive bool
+	// Unavailable reports whether an unavailability period covers the selection time.
+	Unavailable bool
+	// OpenReviews is the number of OPEN PRs the user currently reviews.
+	OpenReviews int
+	// ReviewLimit is the effective open review limit, nil when unlimited.
+	ReviewLimit *int
+	Skills      []string
+	// AssignmentStrategy and ReassignStrategy are the strategies chosen by the member's
+	// team, empty when the team uses the server defaults.
+	AssignmentStrategy string
+	ReassignStrategy   string
+}
+
+// GetPoolMembersTx returns the members of the given teams ordered by team and user_id,
+// skipping archived teams,
+// with their availability at the given time, open review load, effective review limit
+// and skills, in a single statement. The member rows stay share-locked until the
+// transaction ends, so they cannot be deactivated or moved while reviewers are assigned.
+func (r *PRRepo) GetPoolMembersTx(ctx context.Context, tx *sql.Tx, teams []string, at time.Time) ([]PoolMember, error) {
+	return getPoolMembers(ctx, tx, fmt.Sprintf(poolMembersQuery, "FOR SHARE OF u"), teams, at)
+}
+
+// PeekPoolMembersTx returns the same as GetPoolMembersTx without locking any rows,
+// so it can run in a read-only transaction.
+func (r *PRRepo) PeekPoolMembersTx(ctx context.Context, tx *sql.Tx, teams []string, at time.Time) ([]PoolMember, error) {
+	return getPoolMembers(ctx, tx, fmt.Sprintf(poolMembersQuery, ""), teams, at)
+}
+
+// poolMembersQuery selects pool members; the verb is the locking clause of the members.
+const poolMembersQuery = `
+        WITH members AS (
+            SELECT u.user_id, u.team_name, u.is_active, u.max_open_reviews
+            FROM users u
+            JOIN teams t
+              ON t.team_name = u.team_name
+             AND t.archived_at IS NULL
+            WHERE u.team_name = ANY ($1)
+            %s
+        )
+        SELECT
+            m.user_id,
+            m.team_name,
+            m.is_active,
+            EXISTS (
+                SELECT 1
+                FROM user_unavailability ua
+                WHERE ua.user_id = m.user_id
+                  AND ua.starts_at <= $2
+                  AND ua.ends_at > $2
+            ) AS unavailable,
+            (
+                SELECT COUNT(*)
+                FROM pr_reviewers r
+                JOIN pull_requests p ON p.pull_request_id = r.pull_request_id
+                WHERE r.user_id = m.user_id
+                  AND r.replaced_at IS NULL
+                  AND p.status = 'OPEN'
+            ) AS open_reviews,
+            COALESCE(m.max_open_reviews, ts.max_open_reviews) AS review_limit,
+            ARRAY(
+                SELECT s.skill
+                FROM user_skills s
+                WHERE s.user_id = m.user_id
+                ORDER BY s.skill
+            ) AS skills,
+            COALESCE(ts.assignment_strategy, ''),
+            COALESCE(ts.reassign_strategy, '')
+        FROM members m
+        LEFT JOIN team_settings ts ON ts.team_name = m.team_name
+        ORDER BY m.team_name, m.user_id
+    `
+
+func getPoolMembers(ctx context.Context, q queryer, query string, teams []string, at time.Time) ([]PoolMember, error) {
+	rows, err := q.QueryContext(ctx, query, pq.Array(teams), at)
+	if err != nil {
+		return nil, fmt.Errorf("get pool members failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []PoolMember
+	for rows.Next() {
+		var (
+			m     PoolMember
+			limit sql.NullInt64
+		)
+		if err := rows.Scan(
+			&m.UserID,
+			&m.TeamName,
+			&m.IsActive,
+			&m.Unavailable,
+			&m.OpenReviews,
+			&limit,
+			pq.Array(&m.Skills),
+			&m.AssignmentStrategy,
+			&m.ReassignStrategy,
+		); err != nil {
+			return nil, fmt.Errorf("scan pool member: %w", err)
+		}
+		if limit.Valid {
+			l := int(limit.Int64)
+			m.ReviewLimit = &l
+		}
+		result = append(result, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// GetHistory returns every reviewer assignment of a PR, replaced ones included,
+// and every review decision, both in chronological order.
+func (r *PRRepo) GetHistory(ctx context.Context, prID string) (*api.PullRequestHistory, error) {
+	history := &api.PullRequestHistory{
+		Assignments: []api.AssignmentRecord{},
+		Reviews:     []api.Review{},
+	}
+
+	const assignmentsQuery = `
+        SELECT user_id, pool, position, reason, assigned_at, assigned_by, replaced_at, replaced_by
+        FROM pr_reviewers
+        WHERE pull_request_id = $1
+        ORDER BY assigned_at, id
+    `
+
+	rows, err := r.db.QueryContext(ctx, assignmentsQuery, prID)
+	if err != nil {
+		return nil, fmt.Errorf("get assignment history pr=%s failed: %w", prID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			a      api.AssignmentRecord
+			reason []byte
+		)
+		if err := rows.Scan(
+			&a.UserId,
+			&a.Pool,
+			&a.Position,
+			&reason,
+			&a.AssignedAt,
+			&a.AssignedBy,
+			&a.ReplacedAt,
+			&a.ReplacedBy,
+		); err != nil {
+			return nil, fmt.Errorf("scan assignment pr=%s: %w", prID, err)
+		}
+		if reason != nil {
+			a.Reason = &api.AssignmentReason{}
+			if err := json.Unmarshal(reason, a.Reason); err != nil {
+				return nil, fmt.Errorf("decode reviewer reason pr=%s user=%s: %w", prID, a.UserId, err)
+			}
+		}
+		history.Assignments = append(history.Assignments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+
+	const reviewsQuery = `
+        SELECT id, pull_request_id, user_id, state, comment, submitted_at
+        FROM pr_reviews
+        WHERE pull_request_id = $1
+        ORDER BY submitted_at, id
+    `
+
+	reviewRows, err := r.db.QueryContext(ctx, reviewsQuery, prID)
+	if err != nil {
+		return nil, fmt.Errorf("get review history pr=%s failed: %w", prID, err)
+	}
+	defer func() { _ = reviewRows.Close() }()
+
+	for reviewRows.Next() {
+		var review api.Review
+		if err := reviewRows.Scan(
+			&review.Id,
+			&review.PullRequestId,
+			&review.ReviewerId,
+			&review.State,
+			&review.Comment,
+			&review.SubmittedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan review pr=%s: %w", prID, err)
+		}
+		history.Reviews = append(history.Reviews, review)
+	}
+
+	if err := reviewRows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+
+	return history, nil
+}
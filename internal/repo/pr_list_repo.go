@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// PRKey is the position of a PR in the (created_at, pull_request_id) order.
+type PRKey struct {
+	// NotOpen is the leading key when PRFilter.OpenFirst is set.
+	NotOpen       bool
+	CreatedAt     time.Time
+	PullRequestID string
+}
+
+// PRFilter selects PRs for ListPRs. Nil fields do not restrict the result.
+type PRFilter struct {
+	Status      *string
+	AuthorID    *string
+	ReviewerID  *string
+	TeamName    *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	// OpenFirst puts open PRs before the others.
+	OpenFirst bool
+	// Ascending orders from oldest to newest; newest first otherwise.
+	Ascending bool
+	// After returns only PRs following this key in the chosen order.
+	After *PRKey
+	Limit int
+}
+
+// listPRsQuery is completed with the key comparison operator, the leading
+// "not open" key and the ORDER BY list.
+const listPRsQuery = `
+        SELECT
+            p.pull_request_id,
+            p.pull_request_name,
+            p.author_id,
+            p.status,
+            p.repository,
+            p.changed_files,
+            p.required_skills,
+            p.skill_match,
+            p.unmet_owners,
+            p.force_merged,
+            p.created_at,
+            p.merged_at,
+            p.closed_at
+        FROM pull_requests p
+        WHERE ($1::text IS NULL OR p.status = $1)
+          AND ($2::text IS NULL OR p.author_id = $2)
+          AND ($3::text IS NULL OR EXISTS (
+                SELECT 1
+                FROM pr_reviewers r
+                WHERE r.pull_request_id = p.pull_request_id
+                  AND r.user_id = $3
+                  AND r.replaced_at IS NULL
+              ))
+          AND ($4::text IS NULL OR p.author_team_name = (
+                SELECT t.team_name FROM teams t WHERE t.team_name = $4 AND t.archived_at IS NULL
+              ))
+          AND ($5::timestamptz IS NULL OR p.created_at >= $5)
+          AND ($6::timestamptz IS NULL OR p.created_at < $6)
+          AND ($7::timestamptz IS NULL OR p.merged_at >= $7)
+          AND ($8::timestamptz IS NULL OR p.merged_at < $8)
+          AND ($9::timestamptz IS NULL
+               OR %[2]s > $12
+               OR (%[2]s = $12 AND (p.created_at, p.pull_request_id) %[1]s ($9, $10::text)))
+        ORDER BY %[3]s
+        LIMIT $11
+    `
+
+// ListPRs returns up to filter.Limit PRs matching the filter in (created_at, pull_request_id) order.
+func (r *PRRepo) ListPRs(ctx context.Context, filter PRFilter) ([]*api.PullRequest, error) {
+	cmp, dir := "<", "DESC"
+	if filter.Ascending {
+		cmp, dir = ">", "ASC"
+	}
+
+	notOpen := "false"
+	order := fmt.Sprintf("p.created_at %[1]s, p.pull_request_id %[1]s", dir)
+	if filter.OpenFirst {
+		notOpen = "(p.status <> 'OPEN')"
+		order = notOpen + ", " + order
+	}
+
+	query := fmt.Sprintf(listPRsQuery, cmp, notOpen, order)
+
+	var (
+		afterAt      *time.Time
+		afterID      *string
+		afterNotOpen bool
+	)
+	if filter.After != nil {
+		afterAt = &filter.After.CreatedAt
+		afterID = &filter.After.PullRequestID
+		afterNotOpen = filter.After.NotOpen
+	}
+
+	rows, err := r.db.QueryContext(ctx, query,
+		filter.Status,
+		filter.AuthorID,
+		filter.ReviewerID,
+		filter.TeamName,
+		filter.CreatedFrom,
+		filter.CreatedTo,
+		filter.MergedFrom,
+		filter.MergedTo,
+		afterAt,
+		afterID,
+		filter.Limit,
+		afterNotOpen,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list PRs failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := []*api.PullRequest{}
+
+	for rows.Next() {
+		pr, err := scanPR(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan listed PR failed: %w", err)
+		}
+		result = append(result, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+
+	if err := loadReviewers(ctx, r.db, result...); err != nil {
+		return nil, fmt.Errorf("list PRs: %w", err)
+	}
+
+	return result, nil
+}
@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"slices"
 	"time"
 
 	api "ilyaytrewq/PR_assigning_service/internal/api"
@@ -23,27 +22,41 @@ func NewPRRepo(db *sql.DB) *PRRepo {
 	return &PRRepo{db: db}
 }
 
-// CreatePR creates a new pull request.
-func (r *PRRepo) CreatePR(ctx context.Context, pr *api.PullRequest) error {
+// CreatePRTx creates a new pull request with its reviewers.
+// The author's current team is recorded as the team the PR belongs to.
+func (r *PRRepo) CreatePRTx(ctx context.Context, tx *sql.Tx, pr *api.PullRequest) error {
 	const query = `
         INSERT INTO pull_requests (
             pull_request_id,
             pull_request_name,
             author_id,
+            author_team_name,
             status,
-            assigned_reviewers,
+            repository,
+            changed_files,
+            required_skills,
+            skill_match,
+            unmet_owners,
             created_at,
             merged_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ) VALUES (
+            $1, $2, $3,
+            (SELECT team_name FROM users WHERE user_id = $3),
+            $4, $5, $6, $7, $8, $9, $10, $11
+        )
         ON CONFLICT (pull_request_id) DO NOTHING;
     `
 
-	res, err := r.db.ExecContext(ctx, query,
+	res, err := tx.ExecContext(ctx, query,
 		pr.PullRequestId,
 		pr.PullRequestName,
 		pr.AuthorId,
 		pr.Status,
-		pq.Array(pr.AssignedReviewers),
+		pr.Repository,
+		pq.Array(changedFiles(pr)),
+		pq.Array(requiredSkills(pr)),
+		skillMatch(pr),
+		pq.Array(unmetOwners(pr)),
 		pr.CreatedAt,
 		pr.MergedAt,
 	)
@@ -62,40 +75,45 @@ func (r *PRRepo) CreatePR(ctx context.Context, pr *api.PullRequest) error {
 		return ErrPRExists
 	}
 
-	return nil
+	if pr.Reviewers == nil {
+		return nil
+	}
+
+	assignedAt := time.Now().UTC()
+	if pr.CreatedAt != nil {
+		assignedAt = *pr.CreatedAt
+	}
+
+	return insertReviewersTx(ctx, tx, pr.PullRequestId, *pr.Reviewers, 1, assignedByCreate, assignedAt)
 }
 
-// MergePR marks a PR as merged.
-func (r *PRRepo) MergePR(ctx context.Context, prID string, mergedAt time.Time) (*api.PullRequest, error) {
+// MergePRTx marks a PR as merged; forced records that the merge bypassed the merge policy.
+// Merging an already merged PR keeps its original merge time and flag.
+func (r *PRRepo) MergePRTx(ctx context.Context, tx *sql.Tx, prID string, mergedAt time.Time, forced bool) (*api.PullRequest, error) {
 	const query = `
         UPDATE pull_requests
         SET
-            status    = 'MERGED',
-            merged_at = COALESCE(merged_at, $2)
+            status       = 'MERGED',
+            merged_at    = COALESCE(merged_at, $2),
+            force_merged = CASE WHEN status = 'MERGED' THEN force_merged ELSE $3 END
         WHERE pull_request_id = $1
         RETURNING
             pull_request_id,
             pull_request_name,
             author_id,
             status,
-            assigned_reviewers,
+            repository,
+            changed_files,
+            required_skills,
+            skill_match,
+            unmet_owners,
+            force_merged,
             created_at,
-            merged_at;
+            merged_at,
+            closed_at;
     `
 
-	var pr api.PullRequest
-
-	row := r.db.QueryRowContext(ctx, query, prID, mergedAt)
-
-	err := row.Scan(
-		&pr.PullRequestId,
-		&pr.PullRequestName,
-		&pr.AuthorId,
-		&pr.Status,
-		pq.Array(&pr.AssignedReviewers),
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
+	pr, err := scanPR(tx.QueryRowContext(ctx, query, prID, mergedAt, forced))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrPRNotFound
@@ -103,106 +121,161 @@ func (r *PRRepo) MergePR(ctx context.Context, prID string, mergedAt time.Time) (
 		return nil, fmt.Errorf("merge pr id=%s failed: %w", prID, err)
 	}
 
-	return &pr, nil
+	if err := loadReviewers(ctx, tx, pr); err != nil {
+		return nil, fmt.Errorf("merge pr id=%s: %w", prID, err)
+	}
+
+	return pr, nil
 }
 
-// ReassignReviewer replaces a reviewer for a PR.
-func (r *PRRepo) ReassignReviewer(
+// SetStatusTx changes the status of a PR. closed_at is set when the PR is closed
+// and cleared otherwise.
+func (r *PRRepo) SetStatusTx(
 	ctx context.Context,
+	tx *sql.Tx,
 	prID string,
-	oldReviewer string,
-	newReviewer string,
+	status api.PullRequestStatus,
+	at time.Time,
 ) (*api.PullRequest, error) {
+	const query = `
+        UPDATE pull_requests
+        SET
+            status    = $2,
+            closed_at = CASE WHEN $2 = 'CLOSED' THEN $3::timestamptz END
+        WHERE pull_request_id = $1
+    `
 
-	const reviewersQuery = `
-        SELECT assigned_reviewers
-        FROM pull_requests
+	res, err := tx.ExecContext(ctx, query, prID, status, at)
+	if err != nil {
+		return nil, fmt.Errorf("set status pr=%s status=%s failed: %w", prID, status, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("set status pr=%s: rows affected failed: %w", prID, err)
+	}
+
+	if rows == 0 {
+		return nil, ErrPRNotFound
+	}
+
+	return getByID(ctx, tx, prID)
+}
+
+// SetUnmetOwnersTx records the owning teams that had no eligible reviewer for a PR.
+func (r *PRRepo) SetUnmetOwnersTx(ctx context.Context, tx *sql.Tx, prID string, teams []string) error {
+	const query = `
+        UPDATE pull_requests
+        SET unmet_owners = $2
         WHERE pull_request_id = $1
     `
 
-	var reviewers []string
+	res, err := tx.ExecContext(ctx, query, prID, pq.Array(teams))
+	if err != nil {
+		return fmt.Errorf("set unmet owners pr=%s failed: %w", prID, err)
+	}
 
-	err := r.db.QueryRowContext(ctx, reviewersQuery, prID).Scan(
-		pq.Array(&reviewers),
-	)
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("set unmet owners pr=%s: rows affected failed: %w", prID, err)
+	}
+
+	if rows == 0 {
+		return ErrPRNotFound
+	}
+	return nil
+}
 
+// AddReviewersTx appends reviewers to a PR that becomes ready for review.
+func (r *PRRepo) AddReviewersTx(ctx context.Context, tx *sql.Tx, prID string, reviewers []api.ReviewerAssignment, at time.Time) error {
+	last, err := lastPositionTx(ctx, tx, prID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrPRNotFound
-		}
-		return nil, fmt.Errorf("reassign reviewer: get reviewers pr=%s: %w", prID, err)
+		return err
 	}
 
-	idx := slices.Index(reviewers, oldReviewer)
-	if idx == -1 {
-		return nil, ErrUserNotFound
+	if err := insertReviewersTx(ctx, tx, prID, reviewers, last+1, assignedByReady, at); err != nil {
+		return fmt.Errorf("add reviewers pr=%s: %w", prID, err)
 	}
+	return nil
+}
 
-	reviewers[idx] = newReviewer
+// ReassignReviewerTx replaces a reviewer for a PR with the first of the replacements
+// at the same position and appends the remaining ones.
+// The replaced assignment is kept as history.
+func (r *PRRepo) ReassignReviewerTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	prID string,
+	oldReviewer string,
+	replacements []api.ReviewerAssignment,
+	at time.Time,
+) (*api.PullRequest, error) {
 
-	const updateQuery = `
-        UPDATE pull_requests
-        SET assigned_reviewers = $2
+	const replaceQuery = `
+        UPDATE pr_reviewers
+        SET
+            replaced_at = $3,
+            replaced_by = $4
         WHERE pull_request_id = $1
-        RETURNING
-            pull_request_id,
-            pull_request_name,
-            author_id,
-            status,
-            assigned_reviewers,
-            created_at,
-            merged_at;
+          AND user_id = $2
+          AND replaced_at IS NULL
+        RETURNING position
     `
 
-	var pr api.PullRequest
-
-	err = r.db.QueryRowContext(
-		ctx,
-		updateQuery,
-		prID,
-		pq.Array(reviewers),
-	).Scan(
-		&pr.PullRequestId,
-		&pr.PullRequestName,
-		&pr.AuthorId,
-		&pr.Status,
-		pq.Array(&pr.AssignedReviewers),
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
+	var position int
+
+	err := tx.QueryRowContext(ctx, replaceQuery, prID, oldReviewer, at, replacements[0].UserId).Scan(&position)
 	if err != nil {
-		return nil, fmt.Errorf("reassign reviewer: update pr=%s failed: %w", prID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReviewerNotAssigned
+		}
+		return nil, fmt.Errorf("reassign reviewer: replace pr=%s user=%s: %w", prID, oldReviewer, err)
+	}
+
+	if err := insertReviewersTx(ctx, tx, prID, replacements[:1], position, assignedByReassign, at); err != nil {
+		return nil, fmt.Errorf("reassign reviewer pr=%s: %w", prID, err)
+	}
+
+	if len(replacements) > 1 {
+		last, err := lastPositionTx(ctx, tx, prID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := insertReviewersTx(ctx, tx, prID, replacements[1:], last+1, assignedByReassign, at); err != nil {
+			return nil, fmt.Errorf("reassign reviewer pr=%s: %w", prID, err)
+		}
 	}
 
-	return &pr, nil
+	return getByID(ctx, tx, prID)
 }
 
 // GetByID retrieves a PR by its ID.
 func (r *PRRepo) GetByID(ctx context.Context, prID string) (*api.PullRequest, error) {
+	return getByID(ctx, r.db, prID)
+}
+
+func getByID(ctx context.Context, q queryer, prID string) (*api.PullRequest, error) {
 	const query = `
         SELECT
             pull_request_id,
             pull_request_name,
             author_id,
             status,
-            assigned_reviewers,
+            repository,
+            changed_files,
+            required_skills,
+            skill_match,
+            unmet_owners,
+            force_merged,
             created_at,
-            merged_at
+            merged_at,
+            closed_at
         FROM pull_requests
         WHERE pull_request_id = $1;
     `
 
-	var pr api.PullRequest
-
-	err := r.db.QueryRowContext(ctx, query, prID).Scan(
-		&pr.PullRequestId,
-		&pr.PullRequestName,
-		&pr.AuthorId,
-		&pr.Status,
-		pq.Array(&pr.AssignedReviewers),
-		&pr.CreatedAt,
-		&pr.MergedAt,
-	)
+	pr, err := scanPR(q.QueryRowContext(ctx, query, prID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrPRNotFound
@@ -210,56 +283,48 @@ func (r *PRRepo) GetByID(ctx context.Context, prID string) (*api.PullRequest, er
 		return nil, fmt.Errorf("get pr id=%s failed: %w", prID, err)
 	}
 
-	return &pr, nil
+	if err := loadReviewers(ctx, q, pr); err != nil {
+		return nil, fmt.Errorf("get pr id=%s: %w", prID, err)
+	}
+
+	return pr, nil
 }
 
-// GetByReviewer retrieves PRs assigned to a reviewer.
-func (r *PRRepo) GetByReviewer(ctx context.Context, userID string) ([]*api.PullRequest, error) {
+// GetByIDForUpdateTx retrieves a PR by its ID and locks its row until the transaction ends.
+func (r *PRRepo) GetByIDForUpdateTx(ctx context.Context, tx *sql.Tx, prID string) (*api.PullRequest, error) {
 	const query = `
         SELECT
             pull_request_id,
             pull_request_name,
             author_id,
             status,
-            assigned_reviewers,
+            repository,
+            changed_files,
+            required_skills,
+            skill_match,
+            unmet_owners,
+            force_merged,
             created_at,
-            merged_at
+            merged_at,
+            closed_at
         FROM pull_requests
-        WHERE $1 = ANY (assigned_reviewers)
+        WHERE pull_request_id = $1
+        FOR UPDATE;
     `
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	pr, err := scanPR(tx.QueryRowContext(ctx, query, prID))
 	if err != nil {
-		return nil, fmt.Errorf("get PRs by reviewer %s failed: %w", userID, err)
-	}
-	defer func() { _ = rows.Close() }()
-
-	var result []*api.PullRequest
-
-	for rows.Next() {
-		var pr api.PullRequest
-
-		err := rows.Scan(
-			&pr.PullRequestId,
-			&pr.PullRequestName,
-			&pr.AuthorId,
-			&pr.Status,
-			pq.Array(&pr.AssignedReviewers),
-			&pr.CreatedAt,
-			&pr.MergedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("scan PR reviewer=%s failed: %w", userID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPRNotFound
 		}
-
-		result = append(result, &pr)
+		return nil, fmt.Errorf("lock pr id=%s failed: %w", prID, err)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	if err := loadReviewers(ctx, tx, pr); err != nil {
+		return nil, fmt.Errorf("lock pr id=%s: %w", prID, err)
 	}
 
-	return result, nil
+	return pr, nil
 }
 
 // CountPRs returns PR statistics.
@@ -286,7 +351,8 @@ func (r *PRRepo) GetAllUsersWithAssignmentCounts(ctx context.Context) ([]struct
 }, error) {
 	const query = `
 		SELECT user_id, COUNT(*) as assignments
-		FROM pull_requests, unnest(assigned_reviewers) AS user_id
+		FROM pr_reviewers
+		WHERE replaced_at IS NULL
 		GROUP BY user_id;
 	`
 	rows, err := r.db.QueryContext(ctx, query)
@@ -316,3 +382,155 @@ func (r *PRRepo) GetAllUsersWithAssignmentCounts(ctx context.Context) ([]struct
 	}
 	return result, nil
 }
+
+// GetOpenReviewCounts returns the number of OPEN PRs each of the given users is assigned to.
+// Users without open reviews are absent from the result.
+func (r *PRRepo) GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	const query = `
+		SELECT r.user_id, COUNT(*) AS assignments
+		FROM pr_reviewers r
+		JOIN pull_requests p ON p.pull_request_id = r.pull_request_id
+		WHERE p.status = 'OPEN'
+		  AND r.replaced_at IS NULL
+		  AND r.user_id = ANY ($1)
+		GROUP BY r.user_id;
+	`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("get open review counts failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var (
+			userID string
+			count  int
+		)
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("scan open review count failed: %w", err)
+		}
+		result[userID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return result, nil
+}
+
+// CountTeamPRsTx returns the number of PRs created while their author was in the team,
+// whether or not the author is still a member.
+func (r *PRRepo) CountTeamPRsTx(ctx context.Context, tx *sql.Tx, teamName string) (int, error) {
+	const query = `
+        SELECT COUNT(*)
+        FROM pull_requests
+        WHERE author_team_name = $1
+    `
+
+	var count int
+	if err := tx.QueryRowContext(ctx, query, teamName).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count PRs of team %s failed: %w", teamName, err)
+	}
+	return count, nil
+}
+
+// LockOpenReviewPRsTx locks the OPEN PRs any of the users is currently assigned to,
+// in pull_request_id order. Callers that go on to update the users take the PR locks
+// first, in the same order as reassignment, which locks the PR before its pool members.
+func (r *PRRepo) LockOpenReviewPRsTx(ctx context.Context, tx *sql.Tx, userIDs []string) error {
+	const query = `
+        SELECT p.pull_request_id
+        FROM pull_requests p
+        WHERE p.status = 'OPEN'
+          AND EXISTS (
+              SELECT 1
+              FROM pr_reviewers r
+              WHERE r.pull_request_id = p.pull_request_id
+                AND r.replaced_at IS NULL
+                AND r.user_id = ANY ($1)
+          )
+        ORDER BY p.pull_request_id
+        FOR UPDATE
+    `
+
+	if _, err := tx.ExecContext(ctx, query, pq.Array(userIDs)); err != nil {
+		return fmt.Errorf("lock open reviews of users failed: %w", err)
+	}
+	return nil
+}
+
+// GetOpenReviewPRIDsTx returns the IDs of OPEN PRs the user is currently assigned to,
+// in a stable order so that callers lock them consistently.
+func (r *PRRepo) GetOpenReviewPRIDsTx(ctx context.Context, tx *sql.Tx, userID string) ([]string, error) {
+	const query = `
+        SELECT p.pull_request_id
+        FROM pr_reviewers r
+        JOIN pull_requests p ON p.pull_request_id = r.pull_request_id
+        WHERE p.status = 'OPEN'
+          AND r.replaced_at IS NULL
+          AND r.user_id = $1
+        ORDER BY p.pull_request_id
+    `
+
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get open reviews of user=%s failed: %w", userID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan open review of user=%s: %w", userID, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return ids, nil
+}
+
+// UnassignReviewerTx removes a reviewer from a PR without a replacement.
+// The assignment is kept as history with an empty replaced_by.
+func (r *PRRepo) UnassignReviewerTx(ctx context.Context, tx *sql.Tx, prID, userID string, at time.Time) error {
+	const query = `
+        UPDATE pr_reviewers
+        SET replaced_at = $3
+        WHERE pull_request_id = $1
+          AND user_id = $2
+          AND replaced_at IS NULL
+    `
+
+	res, err := tx.ExecContext(ctx, query, prID, userID, at)
+	if err != nil {
+		return fmt.Errorf("unassign reviewer pr=%s user=%s failed: %w", prID, userID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unassign reviewer pr=%s: rows affected failed: %w", prID, err)
+	}
+
+	if rows == 0 {
+		return ErrReviewerNotAssigned
+	}
+	return nil
+}
+
+func lastPositionTx(ctx context.Context, tx *sql.Tx, prID string) (int, error) {
+	const query = `
+        SELECT COALESCE(MAX(position), 0)
+        FROM pr_reviewers
+        WHERE pull_request_id = $1
+    `
+
+	var last int
+	if err := tx.QueryRowContext(ctx, query, prID).Scan(&last); err != nil {
+		return 0, fmt.Errorf("last reviewer position pr=%s: %w", prID, err)
+	}
+	return last, nil
+}
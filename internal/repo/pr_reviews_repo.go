@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	api "ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// InsertReviewTx stores a review decision and fills its ID.
+func (r *PRRepo) InsertReviewTx(ctx context.Context, tx *sql.Tx, review *api.Review) error {
+	const query = `
+        INSERT INTO pr_reviews (pull_request_id, user_id, state, comment, submitted_at)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id
+    `
+
+	err := tx.QueryRowContext(ctx, query,
+		review.PullRequestId,
+		review.ReviewerId,
+		review.State,
+		review.Comment,
+		review.SubmittedAt,
+	).Scan(&review.Id)
+	if err != nil {
+		return fmt.Errorf("insert review pr=%s reviewer=%s failed: %w",
+			review.PullRequestId, review.ReviewerId, err)
+	}
+
+	return nil
+}
+
+// CountPendingReviews returns the number of open PRs where the user is a current
+// reviewer without a decision in the current assignment, as loadReviewers defines it:
+// no APPROVED or CHANGES_REQUESTED since the user was assigned. Comments do not count.
+func (r *PRRepo) CountPendingReviews(ctx context.Context, userID string) (int, error) {
+	const query = `
+        SELECT COUNT(*)
+        FROM pr_reviewers r
+        JOIN pull_requests p
+          ON p.pull_request_id = r.pull_request_id
+         AND p.status = 'OPEN'
+        WHERE r.user_id = $1
+          AND r.replaced_at IS NULL
+          AND NOT EXISTS (
+                SELECT 1
+                FROM pr_reviews v
+                WHERE v.pull_request_id = r.pull_request_id
+                  AND v.user_id = r.user_id
+                  AND v.submitted_at >= r.assigned_at
+                  AND v.state IN ('APPROVED', 'CHANGES_REQUESTED')
+              )
+    `
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count pending reviews user=%s failed: %w", userID, err)
+	}
+	return count, nil
+}
@@ -0,0 +1,255 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	api "ilyaytrewq/PR_assigning_service/internal/api"
+
+	"github.com/lib/pq"
+)
+
+// Values of pr_reviewers.assigned_by.
+const (
+	assignedByCreate   = "create"
+	assignedByReady    = "ready"
+	assignedByReassign = "reassign"
+)
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanPR scans a pull request selected with the columns
+// pull_request_id, pull_request_name, author_id, status, repository, changed_files,
+// required_skills, skill_match, unmet_owners, force_merged, created_at, merged_at, closed_at.
+// Reviewers are filled by loadReviewers.
+func scanPR(row rowScanner) (*api.PullRequest, error) {
+	var (
+		pr         api.PullRequest
+		files      []string
+		skills     []string
+		skillMatch api.SkillMatch
+		unmet      []string
+		forced     bool
+	)
+
+	err := row.Scan(
+		&pr.PullRequestId,
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&pr.Status,
+		&pr.Repository,
+		pq.Array(&files),
+		pq.Array(&skills),
+		&skillMatch,
+		pq.Array(&unmet),
+		&forced,
+		&pr.CreatedAt,
+		&pr.MergedAt,
+		&pr.ClosedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.AssignedReviewers = []string{}
+	pr.Reviewers = &[]api.ReviewerAssignment{}
+
+	if len(files) > 0 {
+		pr.ChangedFiles = &files
+	}
+	if len(skills) > 0 {
+		pr.RequiredSkills = &skills
+		pr.SkillMatch = &skillMatch
+	}
+	if len(unmet) > 0 {
+		pr.UnmetOwners = &unmet
+	}
+	if forced {
+		pr.ForceMerged = &forced
+	}
+
+	return &pr, nil
+}
+
+// loadReviewers fills the current reviewers of the pull requests in assignment order,
+// each with the decision of the current assignment: the latest APPROVED or CHANGES_REQUESTED
+// submitted since the reviewer was assigned, or COMMENTED when the reviewer has only commented.
+// A comment does not withdraw a decision, and a reassigned reviewer starts without one.
+func loadReviewers(ctx context.Context, q queryer, prs ...*api.PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*api.PullRequest, len(prs))
+	ids := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		byID[pr.PullRequestId] = pr
+		ids = append(ids, pr.PullRequestId)
+	}
+
+	const query = `
+        SELECT r.pull_request_id, r.user_id, r.pool, r.matched_skills, r.reason, r.assigned_at, lr.state, lr.submitted_at
+        FROM pr_reviewers r
+        LEFT JOIN LATERAL (
+            SELECT v.state, v.submitted_at
+            FROM pr_reviews v
+            WHERE v.pull_request_id = r.pull_request_id
+              AND v.user_id = r.user_id
+              AND v.submitted_at >= r.assigned_at
+            ORDER BY v.state = 'COMMENTED', v.submitted_at DESC, v.id DESC
+            LIMIT 1
+        ) lr ON true
+        WHERE r.pull_request_id = ANY ($1)
+          AND r.replaced_at IS NULL
+        ORDER BY r.pull_request_id, r.position
+    `
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("load reviewers failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			prID     string
+			reviewer api.ReviewerAssignment
+			matched  []string
+			reason   []byte
+		)
+		if err := rows.Scan(
+			&prID,
+			&reviewer.UserId,
+			&reviewer.Pool,
+			pq.Array(&matched),
+			&reason,
+			&reviewer.AssignedAt,
+			&reviewer.ReviewState,
+			&reviewer.ReviewedAt,
+		); err != nil {
+			return fmt.Errorf("scan reviewer: %w", err)
+		}
+		if len(matched) > 0 {
+			reviewer.MatchedSkills = &matched
+		}
+		if reason != nil {
+			reviewer.Reason = &api.AssignmentReason{}
+			if err := json.Unmarshal(reason, reviewer.Reason); err != nil {
+				return fmt.Errorf("decode reviewer reason pr=%s user=%s: %w", prID, reviewer.UserId, err)
+			}
+		}
+
+		pr := byID[prID]
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer.UserId)
+		*pr.Reviewers = append(*pr.Reviewers, reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return nil
+}
+
+// reviewerRow is a reviewer encoded for insertReviewersTx.
+type reviewerRow struct {
+	UserID        string                `json:"user_id"`
+	Pool          string                `json:"pool"`
+	MatchedSkills []string              `json:"matched_skills"`
+	Reason        *api.AssignmentReason `json:"reason,omitempty"`
+}
+
+// insertReviewersTx stores reviewers of a PR at consecutive positions starting from position,
+// in one statement.
+func insertReviewersTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	prID string,
+	reviewers []api.ReviewerAssignment,
+	position int,
+	assignedBy string,
+	assignedAt time.Time,
+) error {
+	if len(reviewers) == 0 {
+		return nil
+	}
+
+	const query = `
+        INSERT INTO pr_reviewers (
+            pull_request_id,
+            user_id,
+            position,
+            pool,
+            matched_skills,
+            reason,
+            assigned_at,
+            assigned_by
+        )
+        SELECT $1, r.user_id, $2 + r.ord - 1, r.pool, COALESCE(r.matched_skills, '{}'), r.reason, $3, $4
+        FROM ROWS FROM (
+            jsonb_to_recordset($5::jsonb) AS (user_id TEXT, pool TEXT, matched_skills TEXT[], reason JSONB)
+        ) WITH ORDINALITY AS r (user_id, pool, matched_skills, reason, ord)
+        ORDER BY r.ord
+    `
+
+	rows := make([]reviewerRow, 0, len(reviewers))
+	for _, r := range reviewers {
+		row := reviewerRow{UserID: r.UserId, Pool: r.Pool, MatchedSkills: []string{}, Reason: r.Reason}
+		if r.MatchedSkills != nil {
+			row.MatchedSkills = *r.MatchedSkills
+		}
+		rows = append(rows, row)
+	}
+
+	encoded, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("encode reviewers pr=%s: %w", prID, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, prID, position, assignedAt, assignedBy, string(encoded)); err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("insert reviewers pr=%s failed: %w", prID, err)
+	}
+
+	return nil
+}
+
+func changedFiles(pr *api.PullRequest) []string {
+	if pr.ChangedFiles == nil {
+		return []string{}
+	}
+	return *pr.ChangedFiles
+}
+
+func requiredSkills(pr *api.PullRequest) []string {
+	if pr.RequiredSkills == nil {
+		return []string{}
+	}
+	return *pr.RequiredSkills
+}
+
+func unmetOwners(pr *api.PullRequest) []string {
+	if pr.UnmetOwners == nil {
+		return []string{}
+	}
+	return *pr.UnmetOwners
+}
+
+func skillMatch(pr *api.PullRequest) api.SkillMatch {
+	if pr.SkillMatch == nil {
+		return api.Prefer
+	}
+	return *pr.SkillMatch
+}
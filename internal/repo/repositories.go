@@ -6,16 +6,18 @@ import (
 
 // Repositories holds all repository instances.
 type Repositories struct {
-	Teams *TeamRepo
-	Users *UserRepository
-	PRs   *PRRepo
+	Teams      *TeamRepo
+	Users      *UserRepository
+	PRs        *PRRepo
+	CodeOwners *CodeOwnersRepo
 }
 
 // NewRepositories creates a new Repositories instance.
 func NewRepositories(db *sql.DB) *Repositories {
 	return &Repositories{
-		Teams: NewTeamRepo(db),
-		Users: NewUserRepository(db),
-		PRs:   NewPRRepo(db),
+		Teams:      NewTeamRepo(db),
+		Users:      NewUserRepository(db),
+		PRs:        NewPRRepo(db),
+		CodeOwners: NewCodeOwnersRepo(db),
 	}
 }
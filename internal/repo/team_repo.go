@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"ilyaytrewq/PR_assigning_service/internal/api"
+
+	"github.com/lib/pq"
 )
 
 // TeamRepo handles database operations for teams.
@@ -74,11 +77,11 @@ func (tr *TeamRepo) InsertTeam(ctx context.Context, team *api.Team) error {
 // GetTeam retrieves a team by name.
 func (tr *TeamRepo) GetTeam(ctx context.Context, teamName string) (*api.Team, error) {
 	const teamQuery = `
-        SELECT team_name FROM teams WHERE team_name = $1
+        SELECT team_name, archived_at FROM teams WHERE team_name = $1
     `
 	var team api.Team
 
-	err := tr.db.QueryRowContext(ctx, teamQuery, teamName).Scan(&team.TeamName)
+	err := tr.db.QueryRowContext(ctx, teamQuery, teamName).Scan(&team.TeamName, &team.ArchivedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrTeamNotFound
@@ -109,10 +112,124 @@ func (tr *TeamRepo) GetTeam(ctx context.Context, teamName string) (*api.Team, er
 	return &team, nil
 }
 
-// CountTeams returns the total number of teams.
+// LockTeamTx locks the team row so that membership changes of the team are serialized,
+// and reports whether the team is archived.
+func (tr *TeamRepo) LockTeamTx(ctx context.Context, tx *sql.Tx, teamName string) (archived bool, err error) {
+	const query = `
+        SELECT archived_at IS NOT NULL FROM teams WHERE team_name = $1 FOR UPDATE
+    `
+
+	if err := tx.QueryRowContext(ctx, query, teamName).Scan(&archived); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, ErrTeamNotFound
+		}
+		return false, fmt.Errorf("lock team %s failed: %w", teamName, err)
+	}
+	return archived, nil
+}
+
+// ArchiveTeamTx marks the team as archived.
+func (tr *TeamRepo) ArchiveTeamTx(ctx context.Context, tx *sql.Tx, teamName string, at time.Time) error {
+	const query = `
+        UPDATE teams SET archived_at = $2 WHERE team_name = $1
+    `
+
+	res, err := tx.ExecContext(ctx, query, teamName, at)
+	if err != nil {
+		return fmt.Errorf("archive team %s failed: %w", teamName, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("archive team %s: rows affected: %w", teamName, err)
+	}
+
+	if rows == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+// RenameTeamTx renames the team; members, settings and fallback pools follow
+// through ON UPDATE CASCADE.
+func (tr *TeamRepo) RenameTeamTx(ctx context.Context, tx *sql.Tx, teamName, newName string) error {
+	const query = `
+        UPDATE teams SET team_name = $2 WHERE team_name = $1
+    `
+
+	res, err := tx.ExecContext(ctx, query, teamName, newName)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrTeamExists
+		}
+		return fmt.Errorf("rename team %s to %s failed: %w", teamName, newName, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rename team %s: rows affected: %w", teamName, err)
+	}
+
+	if rows == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+// DeleteTeamTx deletes the team; its settings and fallback pools are removed by cascade.
+// The team must have no members.
+func (tr *TeamRepo) DeleteTeamTx(ctx context.Context, tx *sql.Tx, teamName string) error {
+	const query = `
+        DELETE FROM teams WHERE team_name = $1
+    `
+
+	res, err := tx.ExecContext(ctx, query, teamName)
+	if err != nil {
+		return fmt.Errorf("delete team %s failed: %w", teamName, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete team %s: rows affected: %w", teamName, err)
+	}
+
+	if rows == 0 {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+// GetMemberIDsTx returns the IDs of the team members.
+func (tr *TeamRepo) GetMemberIDsTx(ctx context.Context, tx *sql.Tx, teamName string) ([]string, error) {
+	const query = `
+        SELECT user_id FROM users WHERE team_name = $1 ORDER BY user_id
+    `
+
+	rows, err := tx.QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get team %s member ids failed: %w", teamName, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan team %s member id: %w", teamName, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return ids, nil
+}
+
+// CountTeams returns the number of teams that are not archived.
 func (tr *TeamRepo) CountTeams(ctx context.Context) (int, error) {
 	const query = `
-		SELECT COUNT(*) FROM teams
+		SELECT COUNT(*) FROM teams WHERE archived_at IS NULL
 	`
 	var count int
 	err := tr.db.QueryRowContext(ctx, query).Scan(&count)
@@ -121,3 +238,66 @@ func (tr *TeamRepo) CountTeams(ctx context.Context) (int, error) {
 	}
 	return count, nil
 }
+
+// ShareLockTeamsTx share-locks the existing teams among teamNames in name order until the
+// transaction ends, so none of them can be archived, renamed or deleted meanwhile.
+// It reports whether each found team is archived; missing teams are absent from the result.
+func (tr *TeamRepo) ShareLockTeamsTx(ctx context.Context, tx *sql.Tx, teamNames []string) (map[string]bool, error) {
+	const query = `
+        SELECT team_name, archived_at IS NOT NULL
+        FROM teams
+        WHERE team_name = ANY ($1)
+        ORDER BY team_name
+        FOR SHARE
+    `
+	rows, err := tx.QueryContext(ctx, query, pq.Array(teamNames))
+	if err != nil {
+		return nil, fmt.Errorf("share-lock teams failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	archived := make(map[string]bool, len(teamNames))
+	for rows.Next() {
+		var (
+			name       string
+			isArchived bool
+		)
+		if err := rows.Scan(&name, &isArchived); err != nil {
+			return nil, fmt.Errorf("scan locked team: %w", err)
+		}
+		archived[name] = isArchived
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return archived, nil
+}
+
+// MissingTeamsTx returns the names among teamNames that do not exist within a transaction.
+func (tr *TeamRepo) MissingTeamsTx(ctx context.Context, tx *sql.Tx, teamNames []string) ([]string, error) {
+	const query = `
+		SELECT name
+		FROM unnest($1::text[]) AS name
+		WHERE NOT EXISTS (SELECT 1 FROM teams t WHERE t.team_name = name)
+	`
+	rows, err := tx.QueryContext(ctx, query, pq.Array(teamNames))
+	if err != nil {
+		return nil, fmt.Errorf("missing teams query failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var missing []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("scan missing team: %w", err)
+		}
+		missing = append(missing, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return missing, nil
+}
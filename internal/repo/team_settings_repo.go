@@ -0,0 +1,160 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+
+	"github.com/lib/pq"
+)
+
+// defaultReviewersCount mirrors the team_settings.reviewers_count column default.
+const defaultReviewersCount = 2
+
+// GetSettings retrieves team settings, falling back to defaults for teams without stored settings.
+func (tr *TeamRepo) GetSettings(ctx context.Context, teamName string) (*api.TeamSettings, error) {
+	return getSettings(ctx, tr.db, teamName)
+}
+
+// GetSettingsTx retrieves team settings within a transaction.
+func (tr *TeamRepo) GetSettingsTx(ctx context.Context, tx *sql.Tx, teamName string) (*api.TeamSettings, error) {
+	return getSettings(ctx, tx, teamName)
+}
+
+// GetSettingsForUpdateTx retrieves team settings and locks the team row until the
+// transaction ends, so that concurrent updates of the settings are serialized.
+func (tr *TeamRepo) GetSettingsForUpdateTx(ctx context.Context, tx *sql.Tx, teamName string) (*api.TeamSettings, error) {
+	return querySettings(ctx, tx, fmt.Sprintf(settingsQuery, "FOR UPDATE OF t"), teamName)
+}
+
+func getSettings(ctx context.Context, q queryer, teamName string) (*api.TeamSettings, error) {
+	return querySettings(ctx, q, fmt.Sprintf(settingsQuery, ""), teamName)
+}
+
+// settingsQuery selects the settings of a team; the verb is the locking clause.
+const settingsQuery = `
+        SELECT
+            t.team_name,
+            ts.reviewers_count,
+            ts.max_open_reviews,
+            COALESCE(ts.required_approvals, 0),
+            COALESCE(ts.block_on_changes_requested, FALSE),
+            ts.assignment_strategy,
+            ts.reassign_strategy,
+            ARRAY(
+                SELECT f.fallback_team_name
+                FROM team_fallbacks f
+                WHERE f.team_name = t.team_name
+                ORDER BY f.priority
+            )
+        FROM teams t
+        LEFT JOIN team_settings ts ON ts.team_name = t.team_name
+        WHERE t.team_name = $1
+        %s
+    `
+
+func querySettings(ctx context.Context, q queryer, query, teamName string) (*api.TeamSettings, error) {
+
+	var (
+		settings       api.TeamSettings
+		reviewersCount sql.NullInt64
+		maxOpenReviews sql.NullInt64
+		fallbacks      []string
+	)
+
+	err := q.QueryRowContext(ctx, query, teamName).Scan(
+		&settings.TeamName,
+		&reviewersCount,
+		&maxOpenReviews,
+		&settings.RequiredApprovals,
+		&settings.BlockOnChangesRequested,
+		&settings.AssignmentStrategy,
+		&settings.ReassignStrategy,
+		pq.Array(&fallbacks),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("get team %s settings: %w", teamName, err)
+	}
+
+	settings.ReviewersCount = defaultReviewersCount
+	if reviewersCount.Valid {
+		settings.ReviewersCount = int(reviewersCount.Int64)
+	}
+	if maxOpenReviews.Valid {
+		limit := int(maxOpenReviews.Int64)
+		settings.MaxOpenReviews = &limit
+	}
+
+	settings.FallbackTeams = []string{}
+	if len(fallbacks) > 0 {
+		settings.FallbackTeams = fallbacks
+	}
+
+	return &settings, nil
+}
+
+// UpsertSettingsTx stores team settings, replacing its fallback teams, within a transaction.
+// Unknown fallback teams are reported as ErrTeamNotFound.
+func (tr *TeamRepo) UpsertSettingsTx(ctx context.Context, tx *sql.Tx, settings *api.TeamSettings) error {
+	const query = `
+        INSERT INTO team_settings (
+            team_name,
+            reviewers_count,
+            max_open_reviews,
+            required_approvals,
+            block_on_changes_requested,
+            assignment_strategy,
+            reassign_strategy
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (team_name)
+        DO UPDATE SET
+            reviewers_count            = EXCLUDED.reviewers_count,
+            max_open_reviews           = EXCLUDED.max_open_reviews,
+            required_approvals         = EXCLUDED.required_approvals,
+            block_on_changes_requested = EXCLUDED.block_on_changes_requested,
+            assignment_strategy        = EXCLUDED.assignment_strategy,
+            reassign_strategy          = EXCLUDED.reassign_strategy;
+    `
+
+	_, err := tx.ExecContext(ctx, query,
+		settings.TeamName,
+		settings.ReviewersCount,
+		settings.MaxOpenReviews,
+		settings.RequiredApprovals,
+		settings.BlockOnChangesRequested,
+		settings.AssignmentStrategy,
+		settings.ReassignStrategy,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert team %s settings: %w", settings.TeamName, err)
+	}
+
+	const deleteFallbacksQuery = `
+        DELETE FROM team_fallbacks WHERE team_name = $1
+    `
+	if _, err := tx.ExecContext(ctx, deleteFallbacksQuery, settings.TeamName); err != nil {
+		return fmt.Errorf("delete team %s fallbacks: %w", settings.TeamName, err)
+	}
+
+	const insertFallbackQuery = `
+        INSERT INTO team_fallbacks (team_name, fallback_team_name, priority)
+        VALUES ($1, $2, $3)
+    `
+	for i, fallback := range settings.FallbackTeams {
+		_, err := tx.ExecContext(ctx, insertFallbackQuery, settings.TeamName, fallback, i)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return ErrTeamNotFound
+			}
+			return fmt.Errorf("insert team %s fallback %s: %w", settings.TeamName, fallback, err)
+		}
+	}
+
+	return nil
+}
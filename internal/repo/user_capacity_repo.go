@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// GetReviewLimits returns the effective open review limit of each of the given users:
+// the personal limit if set, otherwise the default of the user's team.
+// Users without a limit are absent from the result.
+func (ur *UserRepository) GetReviewLimits(ctx context.Context, userIDs []string) (map[string]int, error) {
+	const query = `
+        SELECT u.user_id, COALESCE(u.max_open_reviews, ts.max_open_reviews)
+        FROM users u
+        LEFT JOIN team_settings ts ON ts.team_name = u.team_name
+        WHERE u.user_id = ANY ($1)
+          AND COALESCE(u.max_open_reviews, ts.max_open_reviews) IS NOT NULL
+    `
+
+	rows, err := ur.db.QueryContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("get review limits failed: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var (
+			userID string
+			limit  int
+		)
+		if err := rows.Scan(&userID, &limit); err != nil {
+			return nil, fmt.Errorf("scan review limit: %w", err)
+		}
+		result[userID] = limit
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return result, nil
+}
+
+// SetMaxOpenReviews sets the personal open review limit of a user; nil removes it.
+func (ur *UserRepository) SetMaxOpenReviews(ctx context.Context, userID string, limit *int) error {
+	const query = `
+        UPDATE users
+        SET max_open_reviews = $2
+        WHERE user_id = $1
+    `
+
+	res, err := ur.db.ExecContext(ctx, query, userID, limit)
+	if err != nil {
+		return fmt.Errorf("set max_open_reviews for user=%s failed: %w", userID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("set max_open_reviews for user=%s: rows affected: %w", userID, err)
+	}
+
+	if rows == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
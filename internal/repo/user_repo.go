@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"ilyaytrewq/PR_assigning_service/internal/api"
+
+	"github.com/lib/pq"
 )
 
 // UserRepository manages user data.
@@ -19,7 +21,8 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-// InsertOrUpdateTx inserts or updates a user within a transaction.
+// InsertOrUpdateTx inserts a user or updates an existing one within a transaction.
+// A user that belongs to another team is not moved: ErrUserInOtherTeam is returned.
 func (ur *UserRepository) InsertOrUpdateTx(ctx context.Context, tx *sql.Tx, user *api.User) error {
 	const query = `
         INSERT INTO users (user_id, username, team_name, is_active)
@@ -28,10 +31,12 @@ func (ur *UserRepository) InsertOrUpdateTx(ctx context.Context, tx *sql.Tx, user
         DO UPDATE SET
             username  = EXCLUDED.username,
             team_name = EXCLUDED.team_name,
-            is_active = EXCLUDED.is_active;
+            is_active = EXCLUDED.is_active
+        WHERE users.team_name IS NULL
+           OR users.team_name = EXCLUDED.team_name;
     `
 
-	_, err := tx.ExecContext(ctx, query,
+	res, err := tx.ExecContext(ctx, query,
 		user.UserId,
 		user.Username,
 		user.TeamName,
@@ -42,30 +47,13 @@ func (ur *UserRepository) InsertOrUpdateTx(ctx context.Context, tx *sql.Tx, user
 		return fmt.Errorf("user insert/update (%s) failed: %w", user.UserId, err)
 	}
 
-	return nil
-}
-
-// InsertOrUpdate inserts or updates a user.
-func (ur *UserRepository) InsertOrUpdate(ctx context.Context, user *api.User) error {
-	const query = `
-        INSERT INTO users (user_id, username, team_name, is_active)
-        VALUES ($1, $2, $3, $4)
-        ON CONFLICT (user_id)
-        DO UPDATE SET
-            username  = EXCLUDED.username,
-            team_name = EXCLUDED.team_name,
-            is_active = EXCLUDED.is_active;
-    `
-
-	_, err := ur.db.ExecContext(ctx, query,
-		user.UserId,
-		user.Username,
-		user.TeamName,
-		user.IsActive,
-	)
-
+	rows, err := res.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("user insert/update (%s) failed: %w", user.UserId, err)
+		return fmt.Errorf("user insert/update (%s): rows affected: %w", user.UserId, err)
+	}
+
+	if rows == 0 {
+		return ErrUserInOtherTeam
 	}
 
 	return nil
@@ -77,7 +65,7 @@ func (ur *UserRepository) SetIsActive(ctx context.Context, userID string, isActi
 		UPDATE users
 		SET is_active = $2
 		WHERE user_id = $1
-		RETURNING user_id, username, team_name, is_active
+		RETURNING user_id, username, COALESCE(team_name, ''), is_active
 	`
 
 	var u api.User
@@ -97,15 +85,24 @@ func (ur *UserRepository) SetIsActive(ctx context.Context, userID string, isActi
 
 // Get retrieves a user by ID.
 func (ur *UserRepository) Get(ctx context.Context, userID string) (*api.User, error) {
+	return getUser(ctx, ur.db, userID)
+}
+
+// GetTx retrieves a user by ID within a transaction.
+func (ur *UserRepository) GetTx(ctx context.Context, tx *sql.Tx, userID string) (*api.User, error) {
+	return getUser(ctx, tx, userID)
+}
+
+func getUser(ctx context.Context, q queryer, userID string) (*api.User, error) {
 	const query = `
-        SELECT user_id, username, team_name, is_active
+        SELECT user_id, username, COALESCE(team_name, ''), is_active
         FROM users
         WHERE user_id = $1;
     `
 
 	var user api.User
 
-	err := ur.db.QueryRowContext(ctx, query, userID).Scan(
+	err := q.QueryRowContext(ctx, query, userID).Scan(
 		&user.UserId,
 		&user.Username,
 		&user.TeamName,
@@ -122,6 +119,117 @@ func (ur *UserRepository) Get(ctx context.Context, userID string) (*api.User, er
 	return &user, nil
 }
 
+// GetForUpdateTx retrieves a user by ID and locks the row until the transaction ends.
+func (ur *UserRepository) GetForUpdateTx(ctx context.Context, tx *sql.Tx, userID string) (*api.User, error) {
+	const query = `
+        SELECT user_id, username, COALESCE(team_name, ''), is_active
+        FROM users
+        WHERE user_id = $1
+        FOR UPDATE
+    `
+
+	var user api.User
+
+	err := tx.QueryRowContext(ctx, query, userID).Scan(
+		&user.UserId,
+		&user.Username,
+		&user.TeamName,
+		&user.IsActive,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("lock user(%s) failed: %w", userID, err)
+	}
+
+	return &user, nil
+}
+
+// GetForShareTx retrieves a user by ID and share-locks the row until the transaction ends,
+// so the user cannot be moved to another team or deleted meanwhile.
+func (ur *UserRepository) GetForShareTx(ctx context.Context, tx *sql.Tx, userID string) (*api.User, error) {
+	const query = `
+        SELECT user_id, username, COALESCE(team_name, ''), is_active
+        FROM users
+        WHERE user_id = $1
+        FOR SHARE
+    `
+
+	var user api.User
+
+	err := tx.QueryRowContext(ctx, query, userID).Scan(
+		&user.UserId,
+		&user.Username,
+		&user.TeamName,
+		&user.IsActive,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("share-lock user(%s) failed: %w", userID, err)
+	}
+
+	return &user, nil
+}
+
+// SetTeamTx moves the user to the team.
+func (ur *UserRepository) SetTeamTx(ctx context.Context, tx *sql.Tx, userID, teamName string) error {
+	const query = `
+        UPDATE users
+        SET team_name = $2
+        WHERE user_id = $1
+    `
+
+	res, err := tx.ExecContext(ctx, query, userID, teamName)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrTeamNotFound
+		}
+		return fmt.Errorf("set team of user=%s failed: %w", userID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("set team of user=%s: rows affected: %w", userID, err)
+	}
+
+	if rows == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// RemoveFromTeamTx detaches the users from the team; they are left without a team.
+func (ur *UserRepository) RemoveFromTeamTx(ctx context.Context, tx *sql.Tx, teamName string, userIDs []string) error {
+	const query = `
+        UPDATE users
+        SET team_name = NULL
+        WHERE team_name = $1
+          AND user_id = ANY ($2)
+    `
+
+	if _, err := tx.ExecContext(ctx, query, teamName, pq.Array(userIDs)); err != nil {
+		return fmt.Errorf("remove users from team %s failed: %w", teamName, err)
+	}
+	return nil
+}
+
+// DeactivateTeamMembersTx deactivates every member of the team.
+func (ur *UserRepository) DeactivateTeamMembersTx(ctx context.Context, tx *sql.Tx, teamName string) error {
+	const query = `
+        UPDATE users
+        SET is_active = false
+        WHERE team_name = $1
+    `
+
+	if _, err := tx.ExecContext(ctx, query, teamName); err != nil {
+		return fmt.Errorf("deactivate members of team %s failed: %w", teamName, err)
+	}
+	return nil
+}
+
 // CountUsersAndActive returns user statistics.
 func (ur *UserRepository) CountUsersAndActive(ctx context.Context) (total int, active int, err error) {
 	const query = `
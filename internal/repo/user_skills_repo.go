@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// GetSkills returns the skills of a user ordered by name.
+func (ur *UserRepository) GetSkills(ctx context.Context, userID string) ([]string, error) {
+	const query = `
+        SELECT skill
+        FROM user_skills
+        WHERE user_id = $1
+        ORDER BY skill
+    `
+
+	rows, err := ur.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get skills user=%s failed: %w", userID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	skills := []string{}
+	for rows.Next() {
+		var skill string
+		if err := rows.Scan(&skill); err != nil {
+			return nil, fmt.Errorf("scan skill user=%s: %w", userID, err)
+		}
+		skills = append(skills, skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return skills, nil
+}
+
+// AddSkills adds skills to a user, ignoring the ones it already has.
+func (ur *UserRepository) AddSkills(ctx context.Context, userID string, skills []string) error {
+	const query = `
+        INSERT INTO user_skills (user_id, skill)
+        SELECT $1, unnest($2::text[])
+        ON CONFLICT (user_id, skill) DO NOTHING
+    `
+
+	if _, err := ur.db.ExecContext(ctx, query, userID, pq.Array(skills)); err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("add skills user=%s failed: %w", userID, err)
+	}
+	return nil
+}
+
+// RemoveSkills removes skills from a user.
+func (ur *UserRepository) RemoveSkills(ctx context.Context, userID string, skills []string) error {
+	const query = `
+        DELETE FROM user_skills
+        WHERE user_id = $1
+          AND skill = ANY ($2)
+    `
+
+	if _, err := ur.db.ExecContext(ctx, query, userID, pq.Array(skills)); err != nil {
+		return fmt.Errorf("remove skills user=%s failed: %w", userID, err)
+	}
+	return nil
+}
+
+// SetSkills replaces the skills of a user in a single statement.
+func (ur *UserRepository) SetSkills(ctx context.Context, userID string, skills []string) error {
+	const query = `
+        WITH removed AS (
+            DELETE FROM user_skills
+            WHERE user_id = $1
+              AND NOT (skill = ANY ($2))
+        )
+        INSERT INTO user_skills (user_id, skill)
+        SELECT $1, unnest($2::text[])
+        ON CONFLICT (user_id, skill) DO NOTHING
+    `
+
+	if _, err := ur.db.ExecContext(ctx, query, userID, pq.Array(skills)); err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("set skills user=%s failed: %w", userID, err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	api "ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// InsertTransferTx records a user transfer between teams and fills its ID.
+func (ur *UserRepository) InsertTransferTx(ctx context.Context, tx *sql.Tx, transfer *api.UserTransfer) error {
+	const query = `
+        INSERT INTO user_transfers (user_id, from_team, to_team, reviews, reassigned, transferred_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id
+    `
+
+	err := tx.QueryRowContext(ctx, query,
+		transfer.UserId,
+		transfer.FromTeam,
+		transfer.ToTeam,
+		transfer.Reviews,
+		transfer.Reassigned,
+		transfer.TransferredAt,
+	).Scan(&transfer.Id)
+	if err != nil {
+		return fmt.Errorf("insert transfer user=%s to=%s failed: %w", transfer.UserId, transfer.ToTeam, err)
+	}
+
+	return nil
+}
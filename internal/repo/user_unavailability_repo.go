@@ -0,0 +1,89 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// InsertUnavailability stores an unavailability period and fills its ID.
+func (ur *UserRepository) InsertUnavailability(ctx context.Context, period *api.Unavailability) error {
+	const query = `
+        INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id
+    `
+
+	err := ur.db.QueryRowContext(ctx, query,
+		period.UserId,
+		period.StartsAt,
+		period.EndsAt,
+		period.Reason,
+	).Scan(&period.Id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("insert unavailability user=%s failed: %w", period.UserId, err)
+	}
+
+	return nil
+}
+
+// GetUnavailability returns the periods of a user that end after the given time, ordered by start.
+func (ur *UserRepository) GetUnavailability(ctx context.Context, userID string, after time.Time) ([]api.Unavailability, error) {
+	const query = `
+        SELECT id, user_id, starts_at, ends_at, reason
+        FROM user_unavailability
+        WHERE user_id = $1
+          AND ends_at > $2
+        ORDER BY starts_at, id
+    `
+
+	rows, err := ur.db.QueryContext(ctx, query, userID, after)
+	if err != nil {
+		return nil, fmt.Errorf("get unavailability user=%s failed: %w", userID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	periods := []api.Unavailability{}
+	for rows.Next() {
+		var p api.Unavailability
+		if err := rows.Scan(&p.Id, &p.UserId, &p.StartsAt, &p.EndsAt, &p.Reason); err != nil {
+			return nil, fmt.Errorf("scan unavailability user=%s: %w", userID, err)
+		}
+		periods = append(periods, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration failed: %w", err)
+	}
+	return periods, nil
+}
+
+// DeleteUnavailability removes an unavailability period of a user.
+func (ur *UserRepository) DeleteUnavailability(ctx context.Context, userID string, id int64) error {
+	const query = `
+        DELETE FROM user_unavailability
+        WHERE id = $1
+          AND user_id = $2
+    `
+
+	res, err := ur.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete unavailability id=%d user=%s failed: %w", id, userID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete unavailability id=%d: rows affected: %w", id, err)
+	}
+
+	if rows == 0 {
+		return ErrUnavailabilityNotFound
+	}
+
+	return nil
+}
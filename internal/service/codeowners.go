@@ -0,0 +1,136 @@
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// ParseCodeOwners parses CODEOWNERS content into rules in file order.
+// Owners are team names; "@" and "org/" prefixes are stripped.
+// A pattern without owners is kept and clears ownership of matching paths.
+func ParseCodeOwners(content string) ([]api.CodeOwnersRule, error) {
+	rules := []api.CodeOwnersRule{}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if idx := strings.Index(line, " #"); idx != -1 {
+			line = strings.TrimSpace(line[:idx])
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if _, err := compilePattern(fields[0]); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCodeOwners, lineNo, err)
+		}
+
+		owners := []string{}
+		for _, owner := range fields[1:] {
+			owner = strings.TrimPrefix(owner, "@")
+			if i := strings.LastIndex(owner, "/"); i != -1 {
+				owner = owner[i+1:]
+			}
+			if owner == "" {
+				return nil, fmt.Errorf("%w: line %d: empty owner", ErrInvalidCodeOwners, lineNo)
+			}
+			if !slices.Contains(owners, owner) {
+				owners = append(owners, owner)
+			}
+		}
+
+		rules = append(rules, api.CodeOwnersRule{Pattern: fields[0], Owners: owners})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCodeOwners, err)
+	}
+	return rules, nil
+}
+
+// codeOwnersMatcher resolves owners of paths with CODEOWNERS semantics:
+// the last matching rule wins.
+type codeOwnersMatcher struct {
+	rules    []api.CodeOwnersRule
+	patterns []*regexp.Regexp
+}
+
+func newCodeOwnersMatcher(rules []api.CodeOwnersRule) (*codeOwnersMatcher, error) {
+	m := &codeOwnersMatcher{rules: rules}
+	for _, rule := range rules {
+		re, err := compilePattern(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: pattern %q: %v", ErrInvalidCodeOwners, rule.Pattern, err)
+		}
+		m.patterns = append(m.patterns, re)
+	}
+	return m, nil
+}
+
+// Match returns the rule that owns path, or nil if no rule matches.
+func (m *codeOwnersMatcher) Match(path string) *api.CodeOwnersRule {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(m.patterns) - 1; i >= 0; i-- {
+		if m.patterns[i].MatchString(path) {
+			return &m.rules[i]
+		}
+	}
+	return nil
+}
+
+// compilePattern converts a gitignore-style CODEOWNERS pattern to a regular expression.
+// Patterns containing a slash other than a trailing one are anchored to the repository root,
+// other patterns match at any depth. "*" and "?" do not cross "/", "**" does.
+// A pattern naming a directory owns everything below it: one with a trailing slash, or one
+// whose last segment has no wildcard. "docs/*" therefore owns only the files directly in docs.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	p := strings.TrimSuffix(pattern, "/")
+	anchored := strings.HasPrefix(p, "/") || strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+
+	last := p[strings.LastIndex(p, "/")+1:]
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.ContainsAny(last, "*?"):
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(b.String())
+}
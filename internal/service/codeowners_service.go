@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// CodeOwnersService handles business logic for CODEOWNERS rules.
+type CodeOwnersService struct {
+	db         *sql.DB
+	codeOwners *repo.CodeOwnersRepo
+	teams      *repo.TeamRepo
+}
+
+// NewCodeOwnersService creates a new CodeOwnersService instance.
+func NewCodeOwnersService(db *sql.DB, codeOwners *repo.CodeOwnersRepo, teams *repo.TeamRepo) *CodeOwnersService {
+	return &CodeOwnersService{db: db, codeOwners: codeOwners, teams: teams}
+}
+
+// Upload parses CODEOWNERS content and replaces the rules of the repository.
+// Every owner must be an existing team that is not archived. The owners stay
+// share-locked until the rules are stored, so none of them can be archived or
+// deleted in between and left behind in the rules.
+func (s *CodeOwnersService) Upload(ctx context.Context, repository string, content string) (_ []api.CodeOwnersRule, err error) {
+	rules, err := ParseCodeOwners(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners []string
+	for _, rule := range rules {
+		owners = append(owners, rule.Owners...)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx UploadCodeOwners: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("UploadCodeOwners rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	found, err := s.teams.ShareLockTeamsTx(ctx, tx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing, archived []string
+	for _, owner := range owners {
+		isArchived, ok := found[owner]
+		switch {
+		case !ok && !slices.Contains(missing, owner):
+			missing = append(missing, owner)
+		case isArchived && !slices.Contains(archived, owner):
+			archived = append(archived, owner)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTeamNotFound, strings.Join(missing, ", "))
+	}
+	if len(archived) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTeamArchived, strings.Join(archived, ", "))
+	}
+
+	if err = s.codeOwners.ReplaceRulesTx(ctx, tx, repository, rules); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx UploadCodeOwners: %w", err)
+	}
+
+	return rules, nil
+}
+
+// GetRules returns the rules of a repository.
+func (s *CodeOwnersService) GetRules(ctx context.Context, repository string) ([]api.CodeOwnersRule, error) {
+	return s.codeOwners.GetRules(ctx, repository)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompilePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		// Unanchored patterns match at any depth.
+		{"*.go", "main.go", true},
+		{"*.go", "internal/service/strategy.go", true},
+		{"*.go", "main.go.txt", false},
+		{"Makefile", "build/Makefile", true},
+
+		// "*" and "?" stay within one segment.
+		{"docs/*", "docs/a.md", true},
+		{"docs/*", "docs/a/b.md", false},
+		{"docs/*.md", "docs/guide/a.md", false},
+		{"/cmd/?.go", "cmd/a.go", true},
+		{"/cmd/?.go", "cmd/ab.go", false},
+
+		// "**" crosses segments.
+		{"docs/**", "docs/a/b.md", true},
+		{"**/testdata", "internal/repo/testdata/x.json", true},
+		{"docs/**/*.md", "docs/a.md", true},
+		{"docs/**/*.md", "docs/a/b/c.md", true},
+		{"docs/**/*.md", "docs/a/b/c.go", false},
+
+		// A plain directory owns everything below it.
+		{"docs/", "docs/a/b.md", true},
+		{"docs/", "src/docs/a.md", true},
+		{"internal/api", "internal/api/generated.go", true},
+		{"internal/api", "internal/apis/x.go", false},
+
+		// Patterns with an inner or leading slash are anchored to the root.
+		{"/docs", "docs/a.md", true},
+		{"/docs", "src/docs/a.md", false},
+		{"internal/api", "vendor/internal/api/x.go", false},
+	}
+
+	for _, tt := range tests {
+		re, err := compilePattern(tt.pattern)
+		if err != nil {
+			t.Fatalf("compilePattern(%q): %v", tt.pattern, err)
+		}
+		if got := re.MatchString(tt.path); got != tt.want {
+			t.Errorf("pattern %q, path %q: got %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCompilePatternEmpty(t *testing.T) {
+	for _, pattern := range []string{"/", "//"} {
+		if _, err := compilePattern(pattern); err == nil {
+			t.Errorf("compilePattern(%q): expected an error", pattern)
+		}
+	}
+}
+
+func TestCodeOwnersMatcherLastRuleWins(t *testing.T) {
+	rules, err := ParseCodeOwners(`
+# default owners
+*            @org/platform
+/docs/       @org/docs    # documentation
+/docs/api/*  @org/api @docs
+/docs/draft
+`)
+	if err != nil {
+		t.Fatalf("ParseCodeOwners: %v", err)
+	}
+
+	m, err := newCodeOwnersMatcher(rules)
+	if err != nil {
+		t.Fatalf("newCodeOwnersMatcher: %v", err)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"main.go", []string{"platform"}},
+		{"docs/index.md", []string{"docs"}},
+		{"/docs/api/users.md", []string{"api", "docs"}},
+		{"docs/api/v1/users.md", []string{"docs"}},
+		{"docs/draft/notes.md", []string{}},
+	}
+
+	for _, tt := range tests {
+		rule := m.Match(tt.path)
+		if rule == nil {
+			t.Fatalf("path %q: no rule matched", tt.path)
+		}
+		if !slices.Equal(rule.Owners, tt.want) {
+			t.Errorf("path %q: got owners %v of %q, want %v", tt.path, rule.Owners, rule.Pattern, tt.want)
+		}
+	}
+}
@@ -8,9 +8,38 @@ var (
 	ErrTeamAlreadyExists = errors.New("team already exists")
 	// ErrTeamNotFound indicates that the team was not found.
 	ErrTeamNotFound = errors.New("team not found")
+	// ErrNotTeamMember indicates that the user is not a member of the team.
+	ErrNotTeamMember = errors.New("user is not a team member")
+	// ErrTeamArchived indicates that the team is archived and its membership cannot change.
+	ErrTeamArchived = errors.New("team is archived")
+	// ErrTeamHasPRs indicates that the team cannot be deleted because PRs were created by its members.
+	ErrTeamHasPRs = errors.New("team has pull requests")
+	// ErrInvalidTeamName indicates that the new team name is empty or unchanged.
+	ErrInvalidTeamName = errors.New("invalid team name")
+
+	// ErrInvalidTeamSettings indicates that the requested team settings are out of range.
+	ErrInvalidTeamSettings = errors.New("invalid team settings")
 
 	// ErrUserNotFound indicates that the user was not found.
 	ErrUserNotFound = errors.New("user not found")
+	// ErrUserInOtherTeam indicates that the user belongs to another team and has to be transferred explicitly.
+	ErrUserInOtherTeam = errors.New("user is in another team")
+	// ErrInvalidTransfer indicates that the user transfer request is malformed.
+	ErrInvalidTransfer = errors.New("invalid transfer")
+
+	// ErrUnavailabilityNotFound indicates that the unavailability period was not found.
+	ErrUnavailabilityNotFound = errors.New("unavailability not found")
+	// ErrInvalidUnavailability indicates that the unavailability period is malformed.
+	ErrInvalidUnavailability = errors.New("invalid unavailability")
+
+	// ErrPolicyNotMet indicates that the PR does not satisfy the merge policy of the author's team.
+	ErrPolicyNotMet = errors.New("merge policy not met")
+
+	// ErrInvalidReviewState indicates that the review decision is unknown.
+	ErrInvalidReviewState = errors.New("invalid review state")
+
+	// ErrInvalidSkills indicates that the provided skills are malformed.
+	ErrInvalidSkills = errors.New("invalid skills")
 
 	// ErrPRNotFound indicates that the pull request was not found.
 	ErrPRNotFound = errors.New("pr not found")
@@ -18,8 +47,22 @@ var (
 	ErrPRAlreadyExists = errors.New("pr already exists")
 	// ErrPRMerged indicates that the pull request is already merged.
 	ErrPRMerged = errors.New("pr already merged")
+	// ErrInvalidState indicates that the operation is not allowed in the current PR status.
+	ErrInvalidState = errors.New("invalid pr state")
+	// ErrInvalidListParams indicates that the PR list filters, limit or cursor are malformed.
+	ErrInvalidListParams = errors.New("invalid list parameters")
 	// ErrReviewerNotAssigned indicates that the user is not assigned as a reviewer.
 	ErrReviewerNotAssigned = errors.New("reviewer not assigned")
 	// ErrNoCandidate indicates that no suitable candidate was found for reassignment.
 	ErrNoCandidate = errors.New("no candidate for reassignment")
+	// ErrNoCapacity indicates that every candidate has reached the open review limit.
+	ErrNoCapacity = errors.New("all candidates are at review capacity")
+	// ErrInvalidReviewLimit indicates that the requested open review limit is out of range.
+	ErrInvalidReviewLimit = errors.New("invalid review limit")
+
+	// ErrInvalidCodeOwners indicates that CODEOWNERS content could not be parsed.
+	ErrInvalidCodeOwners = errors.New("invalid codeowners")
+
+	// ErrUnknownStrategy indicates that no assignment strategy has the requested name.
+	ErrUnknownStrategy = errors.New("unknown assignment strategy")
 )
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+// PolicyNotMetError reports the merge policy conditions a PR does not satisfy.
+// It matches ErrPolicyNotMet with errors.Is.
+type PolicyNotMetError struct {
+	Missing []string
+}
+
+func (e *PolicyNotMetError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrPolicyNotMet, strings.Join(e.Missing, "; "))
+}
+
+func (e *PolicyNotMetError) Unwrap() error {
+	return ErrPolicyNotMet
+}
+
+// checkMergePolicy evaluates the decisions of the current reviewers against the merge
+// policy of the author's team. A decision is the latest APPROVED or CHANGES_REQUESTED of the
+// current assignment, as loaded by the repository, so a later comment neither withdraws an
+// approval nor clears requested changes. settings is nil when the author has no team.
+// Regardless of the team, a PR with owning teams left without a reviewer cannot be merged:
+// every owning team must review it. It returns nil when the PR may be merged.
+func checkMergePolicy(settings *api.TeamSettings, pr *api.PullRequest) *PolicyNotMetError {
+	var missing []string
+	if pr.UnmetOwners != nil && len(*pr.UnmetOwners) > 0 {
+		missing = append(missing, "no reviewer from owning teams: "+strings.Join(*pr.UnmetOwners, ", "))
+	}
+	if settings == nil {
+		if len(missing) == 0 {
+			return nil
+		}
+		return &PolicyNotMetError{Missing: missing}
+	}
+
+	var (
+		approvals        int
+		changesRequested []string
+	)
+	for _, r := range *pr.Reviewers {
+		if r.ReviewState == nil {
+			continue
+		}
+		switch *r.ReviewState {
+		case api.APPROVED:
+			approvals++
+		case api.CHANGESREQUESTED:
+			changesRequested = append(changesRequested, r.UserId)
+		}
+	}
+
+	if approvals < settings.RequiredApprovals {
+		missing = append(missing, fmt.Sprintf("approvals: %d of %d", approvals, settings.RequiredApprovals))
+	}
+	if settings.BlockOnChangesRequested && len(changesRequested) > 0 {
+		missing = append(missing, "changes requested by: "+strings.Join(changesRequested, ", "))
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+	return &PolicyNotMetError{Missing: missing}
+}
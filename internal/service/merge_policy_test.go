@@ -0,0 +1,87 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+func TestCheckMergePolicyUnmetOwners(t *testing.T) {
+	approved := api.APPROVED
+	reviewers := []api.ReviewerAssignment{{UserId: "u1", ReviewState: &approved}}
+	settings := &api.TeamSettings{RequiredApprovals: 1}
+
+	tests := []struct {
+		name     string
+		settings *api.TeamSettings
+		unmet    *[]string
+		want     []string
+	}{
+		{"no team, no owners", nil, nil, nil},
+		{"no team, owners met", nil, &[]string{}, nil},
+		{"no team, owners unmet", nil, &[]string{"payments", "infra"}, []string{"no reviewer from owning teams: payments, infra"}},
+		{"policy met, owners unmet", settings, &[]string{"payments"}, []string{"no reviewer from owning teams: payments"}},
+		{"policy met, owners met", settings, &[]string{}, nil},
+	}
+
+	for _, tt := range tests {
+		pr := &api.PullRequest{Reviewers: &reviewers, UnmetOwners: tt.unmet}
+
+		var got []string
+		if err := checkMergePolicy(tt.settings, pr); err != nil {
+			got = err.Missing
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckMergePolicy(t *testing.T) {
+	state := func(s api.ReviewState) *api.ReviewState { return &s }
+	reviewers := func(states ...*api.ReviewState) *[]api.ReviewerAssignment {
+		out := make([]api.ReviewerAssignment, 0, len(states))
+		for i, s := range states {
+			out = append(out, api.ReviewerAssignment{UserId: "u" + strconv.Itoa(i+1), ReviewState: s})
+		}
+		return &out
+	}
+
+	tests := []struct {
+		name      string
+		approvals int
+		block     bool
+		reviewers *[]api.ReviewerAssignment
+		want      []string
+	}{
+		{"no policy", 0, false, reviewers(nil, nil), nil},
+		{"enough approvals", 2, false, reviewers(state(api.APPROVED), state(api.APPROVED)), nil},
+		{"missing approvals", 2, false, reviewers(state(api.APPROVED), nil), []string{"approvals: 1 of 2"}},
+		{"comments are not approvals", 1, false, reviewers(state(api.COMMENTED)), []string{"approvals: 0 of 1"}},
+		{"no reviewers", 1, false, reviewers(), []string{"approvals: 0 of 1"}},
+		{"changes requested, not blocking", 1, false, reviewers(state(api.APPROVED), state(api.CHANGESREQUESTED)), nil},
+		{"changes requested, blocking", 1, true, reviewers(state(api.APPROVED), state(api.CHANGESREQUESTED)), []string{"changes requested by: u2"}},
+		{"every condition", 2, true, reviewers(state(api.CHANGESREQUESTED), state(api.APPROVED), state(api.CHANGESREQUESTED)),
+			[]string{"approvals: 1 of 2", "changes requested by: u1, u3"}},
+	}
+
+	for _, tt := range tests {
+		settings := &api.TeamSettings{RequiredApprovals: tt.approvals, BlockOnChangesRequested: tt.block}
+		pr := &api.PullRequest{Reviewers: tt.reviewers}
+
+		var got []string
+		err := checkMergePolicy(settings, pr)
+		if err != nil {
+			got = err.Missing
+			if !errors.Is(err, ErrPolicyNotMet) {
+				t.Errorf("%s: %v does not match ErrPolicyNotMet", tt.name, err)
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+func pickFirst([]repo.PoolMember) AssignmentStrategy { return firstStrategy{} }
+
+func excludedReasons(excluded []api.ExcludedCandidate) map[string]api.ExcludedCandidateReason {
+	out := make(map[string]api.ExcludedCandidateReason, len(excluded))
+	for _, e := range excluded {
+		out[e.UserId] = e.Reason
+	}
+	return out
+}
+
+func TestCollectCandidatesCapacity(t *testing.T) {
+	limit := func(n int) *int { return &n }
+
+	tests := []struct {
+		name     string
+		member   repo.PoolMember
+		excluded bool
+	}{
+		{"no limit", repo.PoolMember{OpenReviews: 10}, false},
+		{"below limit", repo.PoolMember{OpenReviews: 1, ReviewLimit: limit(2)}, false},
+		{"at limit", repo.PoolMember{OpenReviews: 2, ReviewLimit: limit(2)}, true},
+		{"over limit", repo.PoolMember{OpenReviews: 3, ReviewLimit: limit(2)}, true},
+		{"zero limit", repo.PoolMember{ReviewLimit: limit(0)}, true},
+	}
+
+	for _, tt := range tests {
+		m := tt.member
+		m.UserID, m.TeamName, m.IsActive = "u1", "backend", true
+
+		candidates, excluded := collectCandidates("backend", []repo.PoolMember{m}, nil, skillRequirement{})
+		if tt.excluded {
+			if len(candidates) != 0 || excludedReasons(excluded)["u1"] != api.AtCapacity {
+				t.Errorf("%s: got candidates %v, excluded %v, want excluded at capacity", tt.name, candidates, excluded)
+			}
+			continue
+		}
+		if len(candidates) != 1 || candidates[0].OpenReviews != m.OpenReviews || len(excluded) != 0 {
+			t.Errorf("%s: got candidates %v, excluded %v, want u1 with %d open reviews", tt.name, candidates, excluded, m.OpenReviews)
+		}
+	}
+}
+
+func TestPickFromPoolsCapacity(t *testing.T) {
+	full := 1
+	members := map[string][]repo.PoolMember{
+		"backend": {
+			{UserID: "u1", TeamName: "backend", IsActive: true, OpenReviews: 1, ReviewLimit: &full},
+			{UserID: "u2", TeamName: "backend", IsActive: true, OpenReviews: 1, ReviewLimit: &full},
+		},
+		"platform": {
+			{UserID: "u3", TeamName: "platform", IsActive: true},
+		},
+		"mobile": {
+			{UserID: "u4", TeamName: "mobile", IsActive: false},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		pools   []string
+		want    []string
+		wantErr error
+	}{
+		{"fallback pool tops up", []string{"backend", "platform"}, []string{"u3"}, nil},
+		{"everyone at capacity", []string{"backend"}, []string{}, ErrNoCapacity},
+		{"nobody at capacity", []string{"mobile"}, []string{}, ErrNoCandidate},
+	}
+
+	for _, tt := range tests {
+		exclude := map[string]api.ExcludedCandidateReason{"u0": api.Author}
+		result := pickFromPools(pickFirst, members, tt.pools, 2, exclude, skillRequirement{}, "")
+		if got := candidateIDs(result.picked); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: picked %v, want %v", tt.name, got, tt.want)
+		}
+		if tt.wantErr != nil {
+			if err := result.noCandidateErr(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: got %v, want %v", tt.name, err, tt.wantErr)
+			}
+		}
+	}
+}
+
+func TestPickFromPoolsUnavailability(t *testing.T) {
+	members := map[string][]repo.PoolMember{
+		"backend": {
+			{UserID: "u1", TeamName: "backend", IsActive: true, Unavailable: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: true, Unavailable: true},
+		},
+		"platform": {
+			{UserID: "u4", TeamName: "platform", IsActive: true, Unavailable: true},
+			{UserID: "u5", TeamName: "platform", IsActive: true},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		pools       []string
+		n           int
+		want        []string
+		unavailable []string
+	}{
+		{"away members skipped", []string{"backend"}, 2, []string{"u2"}, []string{"u1", "u3"}},
+		{"fallback skips its away members", []string{"backend", "platform"}, 2, []string{"u2", "u5"}, []string{"u1", "u3", "u4"}},
+		{"fallback not reached", []string{"backend", "platform"}, 1, []string{"u2"}, []string{"u1", "u3"}},
+	}
+
+	for _, tt := range tests {
+		exclude := map[string]api.ExcludedCandidateReason{"u0": api.Author}
+		result := pickFromPools(pickFirst, members, tt.pools, tt.n, exclude, skillRequirement{}, "")
+
+		if got := candidateIDs(result.picked); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: picked %v, want %v", tt.name, got, tt.want)
+		}
+		var unavailable []string
+		for id, reason := range excludedReasons(result.excluded) {
+			if reason == api.Unavailable {
+				unavailable = append(unavailable, id)
+			}
+		}
+		slices.Sort(unavailable)
+		if !slices.Equal(unavailable, tt.unavailable) {
+			t.Errorf("%s: unavailable %v, want %v", tt.name, unavailable, tt.unavailable)
+		}
+	}
+
+	away := map[string][]repo.PoolMember{"backend": {{UserID: "u1", TeamName: "backend", IsActive: true, Unavailable: true}}}
+	result := pickFromPools(pickFirst, away, []string{"backend"}, 1, map[string]api.ExcludedCandidateReason{}, skillRequirement{}, "")
+	if err := result.noCandidateErr(); !errors.Is(err, ErrNoCandidate) {
+		t.Errorf("everyone away: got %v, want ErrNoCandidate", err)
+	}
+}
+
+func TestPickFromPoolsSkills(t *testing.T) {
+	members := map[string][]repo.PoolMember{
+		"backend": {
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true, Skills: []string{"go", "sql"}},
+			{UserID: "u3", TeamName: "backend", IsActive: true, Skills: []string{"k8s"}},
+			{UserID: "u4", TeamName: "backend", IsActive: true, Skills: []string{"sql"}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		skills        []string
+		match         api.SkillMatch
+		n             int
+		want          []string
+		missingSkills []string
+	}{
+		{"no skills", nil, api.Prefer, 2, []string{"u1", "u2"}, nil},
+		{"prefer puts matching first", []string{"sql"}, api.Prefer, 3, []string{"u2", "u4", "u1"}, nil},
+		{"prefer without matches", []string{"rust"}, api.Prefer, 2, []string{"u1", "u2"}, nil},
+		{"require keeps only matching", []string{"sql"}, api.Require, 3, []string{"u2", "u4"}, []string{"u1", "u3"}},
+		{"require any of the skills", []string{"go", "k8s"}, api.Require, 3, []string{"u2", "u3"}, []string{"u1", "u4"}},
+		{"require without matches", []string{"rust"}, api.Require, 1, []string{}, []string{"u1", "u2", "u3", "u4"}},
+	}
+
+	for _, tt := range tests {
+		req := skillRequirement{skills: tt.skills, match: tt.match}
+		exclude := map[string]api.ExcludedCandidateReason{"u0": api.Author}
+		result := pickFromPools(pickFirst, members, []string{"backend"}, tt.n, exclude, req, "")
+
+		if got := candidateIDs(result.picked); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: picked %v, want %v", tt.name, got, tt.want)
+		}
+		for _, c := range result.picked {
+			for _, skill := range c.MatchedSkills {
+				if !slices.Contains(tt.skills, skill) {
+					t.Errorf("%s: %s matched %q, not a required skill", tt.name, c.UserID, skill)
+				}
+			}
+		}
+
+		var missing []string
+		for id, reason := range excludedReasons(result.excluded) {
+			if reason == api.MissingSkills {
+				missing = append(missing, id)
+			}
+		}
+		slices.Sort(missing)
+		if !slices.Equal(missing, tt.missingSkills) {
+			t.Errorf("%s: excluded for missing skills %v, want %v", tt.name, missing, tt.missingSkills)
+		}
+	}
+}
+
+func TestNewSkillRequirement(t *testing.T) {
+	unknown := api.SkillMatch("any")
+	if _, err := newSkillRequirement(nil, &unknown); !errors.Is(err, ErrInvalidSkills) {
+		t.Errorf("unknown skill_match: got %v, want ErrInvalidSkills", err)
+	}
+
+	req, err := newSkillRequirement(nil, nil)
+	if err != nil || req.match != api.Prefer {
+		t.Errorf("defaults: got %+v, %v, want prefer", req, err)
+	}
+}
+
+func TestCollectCandidatesExclusionReasons(t *testing.T) {
+	full := 0
+	req := skillRequirement{skills: []string{"go"}, match: api.Require}
+	exclude := map[string]api.ExcludedCandidateReason{"author": api.Author, "assigned": api.AlreadyAssigned}
+
+	tests := []struct {
+		member repo.PoolMember
+		want   api.ExcludedCandidateReason
+	}{
+		{repo.PoolMember{UserID: "author", IsActive: true, Skills: []string{"go"}}, api.Author},
+		{repo.PoolMember{UserID: "assigned", IsActive: false, Skills: []string{"go"}}, api.AlreadyAssigned},
+		{repo.PoolMember{UserID: "inactive", IsActive: false, Unavailable: true}, api.Inactive},
+		{repo.PoolMember{UserID: "away", IsActive: true, Unavailable: true, ReviewLimit: &full}, api.Unavailable},
+		{repo.PoolMember{UserID: "full", IsActive: true, ReviewLimit: &full}, api.AtCapacity},
+		{repo.PoolMember{UserID: "unskilled", IsActive: true, Skills: []string{"sql"}}, api.MissingSkills},
+	}
+
+	members := make([]repo.PoolMember, 0, len(tests)+1)
+	for _, tt := range tests {
+		members = append(members, tt.member)
+	}
+	members = append(members, repo.PoolMember{UserID: "eligible", IsActive: true, Skills: []string{"go"}})
+
+	candidates, excluded := collectCandidates("backend", members, exclude, req)
+	if got := candidateIDs(candidates); !slices.Equal(got, []string{"eligible"}) {
+		t.Errorf("candidates %v, want [eligible]", got)
+	}
+
+	reasons := excludedReasons(excluded)
+	if len(excluded) != len(tests) {
+		t.Errorf("excluded %d members, want %d", len(excluded), len(tests))
+	}
+	for _, tt := range tests {
+		if got := reasons[tt.member.UserID]; got != tt.want {
+			t.Errorf("%s: reason %q, want %q", tt.member.UserID, got, tt.want)
+		}
+	}
+	for _, e := range excluded {
+		if e.Pool != "backend" {
+			t.Errorf("%s: pool %q, want backend", e.UserId, e.Pool)
+		}
+	}
+}
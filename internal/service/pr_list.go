@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// PRPage is a page of PRs returned by ListPRs.
+type PRPage struct {
+	PullRequests []*api.PullRequest
+	// NextCursor is nil on the last page.
+	NextCursor *string
+}
+
+// listCursor is the JSON payload of an opaque list cursor.
+type listCursor struct {
+	NotOpen   bool      `json:"not_open,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// ListPRs returns a page of PRs matching the filters, newest first unless
+// sort is created_asc. Pages are keyed by (created_at, pull_request_id), so
+// PRs created while paging do not shift or repeat the following pages.
+func (s *PRService) ListPRs(ctx context.Context, params *api.GetPullRequestListParams) (*PRPage, error) {
+	filter := repo.PRFilter{
+		AuthorID:    params.AuthorId,
+		ReviewerID:  params.ReviewerId,
+		TeamName:    params.TeamName,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		MergedFrom:  params.MergedFrom,
+		MergedTo:    params.MergedTo,
+	}
+
+	var err error
+	if params.Status != nil {
+		if filter.Status, err = listStatus(string(*params.Status)); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.Sort != nil {
+		switch *params.Sort {
+		case api.GetPullRequestListParamsSortCreatedAsc:
+			filter.Ascending = true
+		case api.GetPullRequestListParamsSortCreatedDesc:
+		default:
+			return nil, fmt.Errorf("%w: unknown sort %q", ErrInvalidListParams, *params.Sort)
+		}
+	}
+
+	if isEmptyRange(params.CreatedFrom, params.CreatedTo) || isEmptyRange(params.MergedFrom, params.MergedTo) {
+		return nil, fmt.Errorf("%w: range end must be after its start", ErrInvalidListParams)
+	}
+
+	return listPage(ctx, s.prs, filter, params.Limit, params.Cursor)
+}
+
+// listPage applies the page size and cursor to the filter and fetches the page.
+func listPage(ctx context.Context, prs *repo.PRRepo, filter repo.PRFilter, limit *int, cursor *string) (*PRPage, error) {
+	filter.Limit = defaultListLimit
+	if limit != nil {
+		if *limit < 1 || *limit > maxListLimit {
+			return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidListParams, maxListLimit)
+		}
+		filter.Limit = *limit
+	}
+
+	if cursor != nil && *cursor != "" {
+		after, err := decodeListCursor(*cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+
+	// One extra row tells whether there is a next page.
+	size := filter.Limit
+	filter.Limit++
+
+	list, err := prs.ListPRs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &PRPage{PullRequests: list}
+	if len(list) > size {
+		page.PullRequests = list[:size]
+		next, err := encodeListCursor(page.PullRequests[size-1], filter.OpenFirst)
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = &next
+	}
+
+	return page, nil
+}
+
+// applyOpenFirstSort sets the order of a reviewer or author PR list.
+// open_first is the default of these lists.
+func applyOpenFirstSort(filter *repo.PRFilter, sort string) error {
+	switch api.ReviewerListSortQuery(sort) {
+	case api.ReviewerListSortQueryOpenFirst:
+		filter.OpenFirst = true
+	case api.ReviewerListSortQueryCreatedDesc:
+		filter.OpenFirst = false
+	case api.ReviewerListSortQueryCreatedAsc:
+		filter.OpenFirst = false
+		filter.Ascending = true
+	default:
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidListParams, sort)
+	}
+	return nil
+}
+
+// listStatus validates a status filter.
+func listStatus(status string) (*string, error) {
+	switch api.PullRequestStatus(status) {
+	case api.PullRequestStatusDRAFT, api.PullRequestStatusOPEN, api.PullRequestStatusCLOSED, api.PullRequestStatusMERGED:
+		return &status, nil
+	}
+	return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidListParams, status)
+}
+
+func isEmptyRange(from, to *time.Time) bool {
+	return from != nil && to != nil && !to.After(*from)
+}
+
+func encodeListCursor(pr *api.PullRequest, openFirst bool) (string, error) {
+	c := listCursor{ID: pr.PullRequestId}
+	if openFirst {
+		c.NotOpen = pr.Status != api.PullRequestStatusOPEN
+	}
+	if pr.CreatedAt != nil {
+		c.CreatedAt = *pr.CreatedAt
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("encode list cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeListCursor(cursor string) (*repo.PRKey, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidListParams)
+	}
+
+	var c listCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" || c.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidListParams)
+	}
+
+	return &repo.PRKey{NotOpen: c.NotOpen, CreatedAt: c.CreatedAt, PullRequestID: c.ID}, nil
+}
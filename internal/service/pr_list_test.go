@@ -0,0 +1,65 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+func TestListCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 14, 15, 9, 26, 535897000, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    api.PullRequestStatus
+		openFirst bool
+		want      repo.PRKey
+	}{
+		{"by creation", api.PullRequestStatusMERGED, false, repo.PRKey{CreatedAt: createdAt, PullRequestID: "pr-1"}},
+		{"open first, open PR", api.PullRequestStatusOPEN, true, repo.PRKey{CreatedAt: createdAt, PullRequestID: "pr-1"}},
+		{"open first, closed PR", api.PullRequestStatusCLOSED, true, repo.PRKey{NotOpen: true, CreatedAt: createdAt, PullRequestID: "pr-1"}},
+	}
+
+	for _, tt := range tests {
+		pr := &api.PullRequest{PullRequestId: "pr-1", Status: tt.status, CreatedAt: &createdAt}
+
+		cursor, err := encodeListCursor(pr, tt.openFirst)
+		if err != nil {
+			t.Fatalf("%s: encode: %v", tt.name, err)
+		}
+		got, err := decodeListCursor(cursor)
+		if err != nil {
+			t.Fatalf("%s: decode %q: %v", tt.name, cursor, err)
+		}
+
+		if got.NotOpen != tt.want.NotOpen || !got.CreatedAt.Equal(tt.want.CreatedAt) || got.PullRequestID != tt.want.PullRequestID {
+			t.Errorf("%s: got %+v, want %+v", tt.name, *got, tt.want)
+		}
+	}
+}
+
+func TestDecodeListCursorMalformed(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "!!!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"id":"pr-1"}`))},
+		{"not json", encode("pr-1")},
+		{"no id", encode(`{"created_at":"2025-03-14T15:09:26Z"}`)},
+		{"no created_at", encode(`{"id":"pr-1"}`)},
+		{"bad created_at", encode(`{"id":"pr-1","created_at":"yesterday"}`)},
+	}
+
+	for _, tt := range tests {
+		if _, err := decodeListCursor(tt.cursor); !errors.Is(err, ErrInvalidListParams) {
+			t.Errorf("%s: got %v, want ErrInvalidListParams", tt.name, err)
+		}
+	}
+}
@@ -2,8 +2,13 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"log"
+	"maps"
 	"slices"
+	"strings"
 	"time"
 
 	"ilyaytrewq/PR_assigning_service/internal/api"
@@ -12,77 +17,338 @@ import (
 
 // PRService handles business logic for pull requests.
 type PRService struct {
-	prs   *repo.PRRepo
-	users *repo.UserRepository
-	teams *repo.TeamRepo
+	db         *sql.DB
+	prs        *repo.PRRepo
+	users      *repo.UserRepository
+	teams      *repo.TeamRepo
+	codeOwners *repo.CodeOwnersRepo
+	strategies *Strategies
 }
 
 // NewPRService creates a new PRService instance.
-func NewPRService(prs *repo.PRRepo, users *repo.UserRepository, teams *repo.TeamRepo) *PRService {
+// strategies pick reviewers for new PRs and replacement reviewers from every pool.
+func NewPRService(
+	db *sql.DB,
+	prs *repo.PRRepo,
+	users *repo.UserRepository,
+	teams *repo.TeamRepo,
+	codeOwners *repo.CodeOwnersRepo,
+	strategies *Strategies,
+) *PRService {
 	return &PRService{
-		prs:   prs,
-		users: users,
-		teams: teams,
+		db:         db,
+		prs:        prs,
+		users:      users,
+		teams:      teams,
+		codeOwners: codeOwners,
+		strategies: strategies,
 	}
 }
 
+// ReassignResult describes a completed reviewer reassignment.
+type ReassignResult struct {
+	PR         *api.PullRequest
+	ReplacedBy string
+	// Candidates lists every user the replacement was drawn from.
+	Candidates []string
+}
+
 // CreatePR creates a new pull request and assigns reviewers.
-func (s *PRService) CreatePR(ctx context.Context, body *api.PostPullRequestCreateJSONBody) (*api.PullRequest, error) {
-	author, err := s.users.Get(ctx, body.AuthorId)
+// A draft PR is created without reviewers; they are assigned by ReadyPR.
+// When the request names a repository and changed files, one reviewer is first
+// taken from every team owning the files by CODEOWNERS rules; the rest are
+// picked from the author's team and its fallback pools up to reviewers_count.
+// An owning team without an eligible member does not prevent the PR: it is
+// recorded in UnmetOwners, and the PR cannot be merged without force.
+// The author, settings, CODEOWNERS rules and candidates are read and the PR is
+// inserted in one transaction that keeps the author and the pool members
+// share-locked, so none of them can be moved or deactivated meanwhile.
+func (s *PRService) CreatePR(ctx context.Context, body *api.PostPullRequestCreateJSONBody) (_ *api.PullRequest, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		if errors.Is(err, repo.ErrUserNotFound) {
-			return nil, ErrUserNotFound
+		return nil, fmt.Errorf("begin tx CreatePR: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("CreatePR rollback error: %v", rbErr)
+			}
 		}
-		return nil, err
+	}()
+
+	draft := body.Draft != nil && *body.Draft
+	mode := planAssign
+	if draft {
+		mode = planDraft
 	}
 
-	team, err := s.teams.GetTeam(ctx, author.TeamName)
+	plan, err := s.planReviewers(ctx, tx, body.AuthorId, body.Repository, body.ChangedFiles, body.RequiredSkills, body.SkillMatch, mode)
 	if err != nil {
-		if errors.Is(err, repo.ErrTeamNotFound) {
-			return nil, ErrTeamNotFound
-		}
 		return nil, err
 	}
-
-	var candidates []string
-	for _, m := range team.Members {
-		if !m.IsActive {
-			continue
-		}
-		if m.UserId == author.UserId {
-			continue
-		}
-		candidates = append(candidates, m.UserId)
+	if plan.blocked != nil {
+		return nil, plan.blocked
 	}
 
-	candidates = candidates[0:min(2, len(candidates))]
+	reviewers := plan.assignments
 	now := time.Now().UTC()
 
 	pr := &api.PullRequest{
-		AssignedReviewers: candidates,
-		AuthorId:          author.UserId,
+		AssignedReviewers: candidateIDs(plan.picked),
+		Reviewers:         &reviewers,
+		AuthorId:          plan.author.UserId,
 		CreatedAt:         &now,
 		MergedAt:          nil,
 		PullRequestId:     body.PullRequestId,
 		PullRequestName:   body.PullRequestName,
+		Repository:        body.Repository,
+		ChangedFiles:      body.ChangedFiles,
 		Status:            api.PullRequestStatusOPEN,
 	}
+	if len(plan.unmetOwners) > 0 {
+		pr.UnmetOwners = &plan.unmetOwners
+	}
+	if draft {
+		pr.Status = api.PullRequestStatusDRAFT
+	}
+	if len(plan.req.skills) > 0 {
+		pr.RequiredSkills = &plan.req.skills
+		pr.SkillMatch = &plan.req.match
+	}
 
-	if err := s.prs.CreatePR(ctx, pr); err != nil {
+	if err = s.prs.CreatePRTx(ctx, tx, pr); err != nil {
 		if errors.Is(err, repo.ErrPRExists) {
 			return nil, ErrPRAlreadyExists
 		}
 		return nil, err
 	}
 
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx CreatePR: %w", err)
+	}
+
 	return pr, nil
 }
 
-// MergePR marks a pull request as merged.
-func (s *PRService) MergePR(ctx context.Context, prID string) (*api.PullRequest, error) {
-	now := time.Now().UTC()
+// PreviewPR runs the reviewer selection of CreatePR without creating the PR.
+// Instead of failing when no reviewer can be assigned, it reports the reason in BlockedReason.
+// It has no side effects: it reads in a read-only transaction without locking rows,
+// and picks with a clone of the strategies, so it neither advances round-robin
+// positions nor consumes the shared random generator.
+func (s *PRService) PreviewPR(ctx context.Context, body *api.PostPullRequestPreviewJSONBody) (*api.PullRequestPreview, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("begin tx PreviewPR: %w", err)
+	}
+	defer func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			log.Printf("PreviewPR rollback error: %v", rbErr)
+		}
+	}()
+
+	plan, err := s.planReviewers(ctx, tx, body.AuthorId, body.Repository, body.ChangedFiles, body.RequiredSkills, body.SkillMatch, planPreview)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &api.PullRequestPreview{
+		AuthorId:   plan.author.UserId,
+		Reviewers:  plan.assignments,
+		Candidates: []api.PreviewCandidate{},
+		Excluded:   []api.ExcludedCandidate{},
+	}
+	if plan.blocked != nil {
+		reason := plan.blocked.Error()
+		preview.BlockedReason = &reason
+	}
+	if len(plan.unmetOwners) > 0 {
+		preview.UnmetOwners = &plan.unmetOwners
+	}
+
+	// A pool may be visited twice when an owning team is also the author's team:
+	// report every user once, preferring the candidate entry.
+	seen := make(map[string]bool)
+	for _, c := range plan.considered {
+		if seen[c.UserID] {
+			continue
+		}
+		seen[c.UserID] = true
+		candidate := api.PreviewCandidate{
+			UserId:      c.UserID,
+			Pool:        c.TeamName,
+			OpenReviews: c.OpenReviews,
+		}
+		if len(c.MatchedSkills) > 0 {
+			candidate.MatchedSkills = &c.MatchedSkills
+		}
+		preview.Candidates = append(preview.Candidates, candidate)
+	}
+	for _, e := range plan.excluded {
+		if seen[e.UserId] {
+			continue
+		}
+		seen[e.UserId] = true
+		preview.Excluded = append(preview.Excluded, e)
+	}
+
+	return preview, nil
+}
+
+// reviewerPlan is the reviewer selection for a new PR.
+type reviewerPlan struct {
+	author      *api.User
+	req         skillRequirement
+	picked      []Candidate
+	assignments []api.ReviewerAssignment
+	// considered and excluded cover the members of every visited pool.
+	considered []Candidate
+	excluded   []api.ExcludedCandidate
+	// unmetOwners lists the owning teams nobody could be picked from.
+	unmetOwners []string
+	// blocked is the error creating the PR fails with, nil when it can be created.
+	blocked error
+}
+
+// planMode tells planReviewers what the plan is for.
+type planMode int
+
+const (
+	// planAssign plans reviewers to assign: the author and the pool members stay
+	// share-locked until tx ends, and the strategies advance.
+	planAssign planMode = iota
+	// planDraft resolves only the author and the skill requirement of a draft.
+	planDraft
+	// planPreview plans without locking rows or advancing the strategies.
+	planPreview
+)
+
+// planReviewers selects reviewers for a PR of the author: one from every team owning
+// the changed files, then the rest from the author's team and its fallback pools.
+// Everything is read within tx.
+func (s *PRService) planReviewers(
+	ctx context.Context,
+	tx *sql.Tx,
+	authorID string,
+	repository *string,
+	changedFiles *[]string,
+	requiredSkills *[]string,
+	skillMatch *api.SkillMatch,
+	mode planMode,
+) (*reviewerPlan, error) {
+	getAuthor, strategies := s.users.GetForShareTx, s.strategies
+	if mode == planPreview {
+		getAuthor, strategies = s.users.GetTx, s.strategies.Clone()
+	}
+
+	author, err := getAuthor(ctx, tx, authorID)
+	if err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	req, err := newSkillRequirement(requiredSkills, skillMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &reviewerPlan{
+		author:      author,
+		req:         req,
+		picked:      []Candidate{},
+		assignments: []api.ReviewerAssignment{},
+		unmetOwners: []string{},
+	}
+	if mode == planDraft {
+		return plan, nil
+	}
+
+	settings, err := s.teamSettingsTx(ctx, tx, author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	owningTeams, err := s.owningTeams(ctx, tx, repository, changedFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := append([]string{author.TeamName}, settings.FallbackTeams...)
+	allPools := slices.Clone(pools)
+	for _, owner := range owningTeams {
+		allPools = append(allPools, owner.team)
+	}
+
+	members, err := s.poolMembers(ctx, tx, allPools, mode != planPreview)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude := map[string]api.ExcludedCandidateReason{author.UserId: api.Author}
+
+	for _, owner := range owningTeams {
+		owners := pickFromPools(strategies.forAssign, members, []string{owner.team}, 1, exclude, req, owner.rule)
+		plan.add(owners)
+		if len(owners.picked) == 0 {
+			plan.unmetOwners = append(plan.unmetOwners, owner.team)
+			continue
+		}
+		exclude[owners.picked[0].UserID] = api.AlreadyAssigned
+	}
+
+	rest := pickFromPools(strategies.forAssign, members, pools, settings.ReviewersCount-len(plan.picked), exclude, req, "")
+	plan.add(rest)
+	if len(plan.picked) == 0 && errors.Is(rest.noCandidateErr(), ErrNoCapacity) {
+		plan.blocked = ErrNoCapacity
+	}
+
+	return plan, nil
+}
+
+func (p *reviewerPlan) add(pick *poolPick) {
+	p.picked = append(p.picked, pick.picked...)
+	p.assignments = append(p.assignments, pick.assignments...)
+	p.considered = append(p.considered, pick.considered...)
+	p.excluded = append(p.excluded, pick.excluded...)
+}
+
+// GetPR returns a pull request with its reviewer assignment metadata
+// and the history of its assignments and review decisions.
+func (s *PRService) GetPR(ctx context.Context, prID string) (*api.PullRequest, *api.PullRequestHistory, error) {
+	pr, err := s.prs.GetByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repo.ErrPRNotFound) {
+			return nil, nil, ErrPRNotFound
+		}
+		return nil, nil, err
+	}
 
-	pr, err := s.prs.MergePR(ctx, prID, now)
+	history, err := s.prs.GetHistory(ctx, prID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pr, history, nil
+}
+
+// MergePR marks a pull request as merged if it satisfies the merge policy of the
+// author's team; otherwise it fails with *PolicyNotMetError unless force is set,
+// in which case the merge is recorded as forced. Merging a merged PR returns it unchanged;
+// drafts and closed PRs cannot be merged.
+func (s *PRService) MergePR(ctx context.Context, prID string, force bool) (_ *api.PullRequest, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx MergePR: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("MergePR rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	pr, err := s.prs.GetByIDForUpdateTx(ctx, tx, prID)
 	if err != nil {
 		if errors.Is(err, repo.ErrPRNotFound) {
 			return nil, ErrPRNotFound
@@ -90,76 +356,306 @@ func (s *PRService) MergePR(ctx context.Context, prID string) (*api.PullRequest,
 		return nil, err
 	}
 
-	return pr, nil
-}
+	switch pr.Status {
+	case api.PullRequestStatusDRAFT, api.PullRequestStatusCLOSED:
+		return nil, fmt.Errorf("%w: cannot merge %s PR", ErrInvalidState, pr.Status)
+	}
+
+	// The author's team and its policy are read in the transaction holding the PR lock.
+	// An author removed from every team has no team policy to satisfy, but still needs
+	// reviewers from every owning team.
+	forced := false
+	if pr.Status == api.PullRequestStatusOPEN {
+		author, err := s.users.GetTx(ctx, tx, pr.AuthorId)
+		if err != nil {
+			if errors.Is(err, repo.ErrUserNotFound) {
+				return nil, ErrUserNotFound
+			}
+			return nil, err
+		}
+
+		var settings *api.TeamSettings
+		if author.TeamName != "" {
+			settings, err = s.teamSettingsTx(ctx, tx, author.TeamName)
+			if err != nil {
+				return nil, err
+			}
+		}
 
-// ReassignReviewer replaces a reviewer with another candidate.
-func (s *PRService) ReassignReviewer(ctx context.Context, body *api.PostPullRequestReassignJSONBody) (*api.PullRequest, string, error) {
-	pr, err := s.prs.GetByID(ctx, body.PullRequestId)
+		if policyErr := checkMergePolicy(settings, pr); policyErr != nil {
+			if !force {
+				return nil, policyErr
+			}
+			forced = true
+		}
+	}
+
+	merged, err := s.prs.MergePRTx(ctx, tx, prID, time.Now().UTC(), forced)
 	if err != nil {
 		if errors.Is(err, repo.ErrPRNotFound) {
-			return nil, "", ErrPRNotFound
+			return nil, ErrPRNotFound
 		}
-		return nil, "", err
+		return nil, err
 	}
 
-	if pr.Status == api.PullRequestStatusMERGED {
-		return nil, "", ErrPRMerged
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx MergePR: %w", err)
 	}
 
-	idx := slices.Index(pr.AssignedReviewers, body.OldUserId)
-	if idx == -1 {
-		return nil, "", ErrReviewerNotAssigned
+	return merged, nil
+}
+
+// ReassignReviewer replaces a reviewer with another candidate from the reviewer's team,
+// falling back to the team's fallback pools when it has no candidates left.
+// When the PR has fewer reviewers than the author's team reviewers_count,
+// the missing reviewers are added the same way.
+// The PR row stays locked for the whole reassignment, so concurrent reassignments
+// and merges of the same PR are serialized and no reviewer is changed after MERGED.
+// Any returned error rolls the transaction back.
+func (s *PRService) ReassignReviewer(ctx context.Context, body *api.PostPullRequestReassignJSONBody) (_ *ReassignResult, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx ReassignReviewer: %w", err)
 	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("ReassignReviewer rollback error: %v", rbErr)
+			}
+		}
+	}()
 
-	oldReviewer, err := s.users.Get(ctx, body.OldUserId)
+	oldReviewer, err := s.users.GetTx(ctx, tx, body.OldUserId)
 	if err != nil {
 		if errors.Is(err, repo.ErrUserNotFound) {
-			return nil, "", ErrUserNotFound
+			return nil, ErrUserNotFound
 		}
-		return nil, "", err
+		return nil, err
 	}
 
-	team, err := s.teams.GetTeam(ctx, oldReviewer.TeamName)
+	res, err := s.reassignTx(ctx, tx, body.PullRequestId, body.OldUserId, oldReviewer.TeamName)
 	if err != nil {
-		if errors.Is(err, repo.ErrTeamNotFound) {
-			return nil, "", ErrTeamNotFound
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx ReassignReviewer: %w", err)
+	}
+
+	return res, nil
+}
+
+// reassignTx replaces the reviewer of a PR with a candidate from team or its fallback pools
+// within the caller's transaction, locking the PR row. Everything is read through tx, so
+// membership changes the caller has made are taken into account.
+func (s *PRService) reassignTx(ctx context.Context, tx *sql.Tx, prID, oldUserID, team string) (*ReassignResult, error) {
+	if team == "" {
+		return nil, fmt.Errorf("%w: reviewer %s has no team", ErrNoCandidate, oldUserID)
+	}
+
+	pr, err := s.prs.GetByIDForUpdateTx(ctx, tx, prID)
+	if err != nil {
+		if errors.Is(err, repo.ErrPRNotFound) {
+			return nil, ErrPRNotFound
 		}
-		return nil, "", err
+		return nil, err
 	}
 
-	var candidates []string
-	for _, m := range team.Members {
-		if !m.IsActive {
-			continue
+	if err := requireOpen(pr); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(pr.AssignedReviewers, oldUserID) {
+		return nil, ErrReviewerNotAssigned
+	}
+
+	author, err := s.users.GetTx(ctx, tx, pr.AuthorId)
+	if err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
 		}
-		if m.UserId == oldReviewer.UserId {
-			continue
+		return nil, err
+	}
+
+	// Missing reviewers are topped up to the reviewers_count of the author's team;
+	// an author without a team gets only the replacement.
+	missing := 0
+	if author.TeamName != "" {
+		authorSettings, err := s.teamSettingsTx(ctx, tx, author.TeamName)
+		if err != nil {
+			return nil, err
 		}
-		if m.UserId == pr.AuthorId {
-			continue
+		missing = max(0, authorSettings.ReviewersCount-len(pr.AssignedReviewers))
+	}
+
+	reviewerSettings, err := s.teamSettingsTx(ctx, tx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := append([]string{team}, reviewerSettings.FallbackTeams...)
+	exclude := map[string]api.ExcludedCandidateReason{pr.AuthorId: api.Author}
+	for _, id := range pr.AssignedReviewers {
+		exclude[id] = api.AlreadyAssigned
+	}
+
+	req, err := newSkillRequirement(pr.RequiredSkills, pr.SkillMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.poolMembers(ctx, tx, pools, true)
+	if err != nil {
+		return nil, err
+	}
+
+	pick := pickFromPools(s.strategies.forReassign, members, pools, 1+missing, exclude, req, "")
+	if len(pick.picked) == 0 {
+		return nil, pick.noCandidateErr()
+	}
+
+	newReviewerID := pick.picked[0].UserID
+
+	updatedPR, err := s.prs.ReassignReviewerTx(ctx, tx, prID, oldUserID, pick.assignments, time.Now().UTC())
+	if err != nil {
+		switch {
+		case errors.Is(err, repo.ErrPRNotFound):
+			return nil, ErrPRNotFound
+		case errors.Is(err, repo.ErrReviewerNotAssigned):
+			return nil, ErrReviewerNotAssigned
+		case errors.Is(err, repo.ErrUserNotFound):
+			return nil, ErrUserNotFound
 		}
-		if slices.Contains(pr.AssignedReviewers, m.UserId) {
+		return nil, err
+	}
+
+	return &ReassignResult{
+		PR:         updatedPR,
+		ReplacedBy: newReviewerID,
+		Candidates: candidateIDs(pick.considered),
+	}, nil
+}
+
+// countTeamPRsTx returns the number of PRs created by authors while they were in the team.
+func (s *PRService) countTeamPRsTx(ctx context.Context, tx *sql.Tx, team string) (int, error) {
+	return s.prs.CountTeamPRsTx(ctx, tx, team)
+}
+
+// lockOpenReviewsTx locks the open PRs the users review. Callers that change the users and
+// then hand their reviews over take these locks first, in the order ReassignReviewer takes
+// them: the PR before its pool members.
+func (s *PRService) lockOpenReviewsTx(ctx context.Context, tx *sql.Tx, userIDs []string) error {
+	return s.prs.LockOpenReviewPRsTx(ctx, tx, userIDs)
+}
+
+// handOverReviewsTx reassigns the open reviews of a user leaving team to candidates of the
+// team and its fallback pools, as ReassignReviewer does. A review without candidates is
+// unassigned. PRs merged, closed or reassigned concurrently are skipped.
+func (s *PRService) handOverReviewsTx(ctx context.Context, tx *sql.Tx, userID, team string) ([]api.ReviewHandoff, error) {
+	prIDs, err := s.prs.GetOpenReviewPRIDsTx(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	handoffs := []api.ReviewHandoff{}
+	for _, prID := range prIDs {
+		handoff := api.ReviewHandoff{PullRequestId: prID, OldReviewerId: userID}
+
+		res, err := s.reassignTx(ctx, tx, prID, userID, team)
+		switch {
+		case err == nil:
+			handoff.NewReviewerId = &res.ReplacedBy
+		case errors.Is(err, ErrNoCandidate), errors.Is(err, ErrNoCapacity):
+			if err := s.prs.UnassignReviewerTx(ctx, tx, prID, userID, time.Now().UTC()); err != nil {
+				return nil, err
+			}
+		case errors.Is(err, ErrPRMerged), errors.Is(err, ErrInvalidState), errors.Is(err, ErrReviewerNotAssigned):
 			continue
+		default:
+			return nil, err
 		}
-		candidates = append(candidates, m.UserId)
+
+		handoffs = append(handoffs, handoff)
 	}
+	return handoffs, nil
+}
 
-	if len(candidates) == 0 {
-		return nil, "", ErrNoCandidate
+// SubmitReview records a review decision of a current reviewer of an open PR.
+// The PR row is locked so that the reviewer cannot be replaced or the PR merged meanwhile.
+func (s *PRService) SubmitReview(
+	ctx context.Context,
+	body *api.PostPullRequestReviewJSONBody,
+) (_ *api.PullRequest, _ *api.Review, err error) {
+	switch body.State {
+	case api.APPROVED, api.CHANGESREQUESTED, api.COMMENTED:
+	default:
+		return nil, nil, fmt.Errorf("%w: %q", ErrInvalidReviewState, body.State)
 	}
 
-	newReviewerID := candidates[0]
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("begin tx SubmitReview: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("SubmitReview rollback error: %v", rbErr)
+			}
+		}
+	}()
 
-	updatedPR, err := s.prs.ReassignReviewer(ctx, body.PullRequestId, body.OldUserId, newReviewerID)
+	pr, err := s.prs.GetByIDForUpdateTx(ctx, tx, body.PullRequestId)
 	if err != nil {
 		if errors.Is(err, repo.ErrPRNotFound) {
-			return nil, "", ErrPRNotFound
+			return nil, nil, ErrPRNotFound
 		}
-		return nil, "", err
+		return nil, nil, err
+	}
+
+	if err = requireOpen(pr); err != nil {
+		return nil, nil, err
+	}
+
+	if !slices.Contains(pr.AssignedReviewers, body.ReviewerId) {
+		return nil, nil, ErrReviewerNotAssigned
+	}
+
+	review := &api.Review{
+		PullRequestId: pr.PullRequestId,
+		ReviewerId:    body.ReviewerId,
+		State:         body.State,
+		SubmittedAt:   time.Now().UTC(),
+	}
+	if body.Comment != nil {
+		review.Comment = *body.Comment
+	}
+
+	if err = s.prs.InsertReviewTx(ctx, tx, review); err != nil {
+		return nil, nil, err
 	}
 
-	return updatedPR, newReviewerID, nil
+	if err = tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("commit tx SubmitReview: %w", err)
+	}
+
+	applyReview(*pr.Reviewers, review)
+	return pr, review, nil
+}
+
+// applyReview updates the decision of the reviewer who submitted review, as loadReviewers
+// would reload it: APPROVED and CHANGES_REQUESTED replace the decision, while a comment is
+// recorded only when the reviewer has not decided yet.
+func applyReview(reviewers []api.ReviewerAssignment, review *api.Review) {
+	for i := range reviewers {
+		reviewer := &reviewers[i]
+		if reviewer.UserId != review.ReviewerId {
+			continue
+		}
+		if review.State != api.COMMENTED || reviewer.ReviewState == nil || *reviewer.ReviewState == api.COMMENTED {
+			reviewer.ReviewState = &review.State
+			reviewer.ReviewedAt = &review.SubmittedAt
+		}
+	}
 }
 
 // GetCountPRs returns PR statistics.
@@ -174,3 +670,277 @@ func (s *PRService) GetAllUsersWithAssignmentCounts(ctx context.Context) ([]stru
 }, error) {
 	return s.prs.GetAllUsersWithAssignmentCounts(ctx)
 }
+
+func (s *PRService) teamSettingsTx(ctx context.Context, tx *sql.Tx, teamName string) (*api.TeamSettings, error) {
+	settings, err := s.teams.GetSettingsTx(ctx, tx, teamName)
+	if err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+	return settings, nil
+}
+
+// ownership is a team owning some of the changed files by the CODEOWNERS rule pattern.
+type ownership struct {
+	team string
+	rule string
+}
+
+// owningTeams returns the teams owning the changed files by the repository's
+// CODEOWNERS rules, in order of first appearance, each with the first rule that made it an owner.
+// Owners are validated on upload and dropped from the rules with their team, so an owner
+// that is not a team is a stale rule entry: it is skipped rather than failing the PR
+// as if its author or team were missing.
+func (s *PRService) owningTeams(ctx context.Context, tx *sql.Tx, repository *string, files *[]string) ([]ownership, error) {
+	if repository == nil || files == nil || len(*files) == 0 {
+		return nil, nil
+	}
+
+	rules, err := s.codeOwners.GetRulesTx(ctx, tx, *repository)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher, err := newCodeOwnersMatcher(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners []ownership
+	for _, file := range *files {
+		rule := matcher.Match(file)
+		if rule == nil {
+			continue
+		}
+		for _, owner := range rule.Owners {
+			known := slices.ContainsFunc(owners, func(o ownership) bool { return o.team == owner })
+			if !known {
+				owners = append(owners, ownership{team: owner, rule: rule.Pattern})
+			}
+		}
+	}
+	if len(owners) == 0 {
+		return owners, nil
+	}
+
+	names := make([]string, 0, len(owners))
+	for _, o := range owners {
+		names = append(names, o.team)
+	}
+	missing, err := s.teams.MissingTeamsTx(ctx, tx, names)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		log.Printf("skipping unknown CODEOWNERS owners of %s: %s", *repository, strings.Join(missing, ", "))
+		owners = slices.DeleteFunc(owners, func(o ownership) bool { return slices.Contains(missing, o.team) })
+	}
+	return owners, nil
+}
+
+// skillRequirement describes the skills a PR asks its reviewers for.
+type skillRequirement struct {
+	skills []string
+	match  api.SkillMatch
+}
+
+func newSkillRequirement(skills *[]string, match *api.SkillMatch) (skillRequirement, error) {
+	req := skillRequirement{match: api.Prefer}
+	if match != nil {
+		switch *match {
+		case api.Prefer, api.Require:
+			req.match = *match
+		default:
+			return req, fmt.Errorf("%w: unknown skill_match %q", ErrInvalidSkills, *match)
+		}
+	}
+	if skills != nil {
+		normalized, err := normalizeSkills(*skills)
+		if err != nil {
+			return req, err
+		}
+		req.skills = normalized
+	}
+	return req, nil
+}
+
+// poolPick is the outcome of picking reviewers from candidate pools.
+type poolPick struct {
+	picked []Candidate
+	// assignments describe the picks with the reason each was picked for.
+	assignments []api.ReviewerAssignment
+	// considered lists every candidate the picks were drawn from.
+	considered []Candidate
+	// excluded lists pool members that could not be picked, with the reason.
+	excluded []api.ExcludedCandidate
+}
+
+// noCandidateErr explains why nobody was picked.
+func (p *poolPick) noCandidateErr() error {
+	atCapacity := slices.ContainsFunc(p.excluded, func(e api.ExcludedCandidate) bool {
+		return e.Reason == api.AtCapacity
+	})
+	if atCapacity {
+		return ErrNoCapacity
+	}
+	return ErrNoCandidate
+}
+
+// poolMembers loads the members of the pools in one statement, grouped by team,
+// share-locking them when lock is set.
+// A pool that is not an existing team is reported as ErrTeamNotFound; an archived
+// team is a pool without members.
+func (s *PRService) poolMembers(ctx context.Context, tx *sql.Tx, pools []string, lock bool) (map[string][]repo.PoolMember, error) {
+	missing, err := s.teams.MissingTeamsTx(ctx, tx, pools)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTeamNotFound, strings.Join(missing, ", "))
+	}
+
+	getMembers := s.prs.PeekPoolMembersTx
+	if lock {
+		getMembers = s.prs.GetPoolMembersTx
+	}
+
+	members, err := getMembers(ctx, tx, pools, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	byTeam := make(map[string][]repo.PoolMember, len(pools))
+	for _, m := range members {
+		byTeam[m.TeamName] = append(byTeam[m.TeamName], m)
+	}
+	return byTeam, nil
+}
+
+// poolStrategy resolves the strategy picking from the members of a pool.
+type poolStrategy func(members []repo.PoolMember) AssignmentStrategy
+
+// pickFromPools picks up to n reviewers from the first pool and tops them up from
+// the following pools in order, each pool by its own strategy. Users in exclude are never picked.
+// The strategy picks candidates with the required skills first in one pass over the pool,
+// so a stateful strategy advances once per pool; with skills required, they are the only candidates.
+// A non-empty rule is the CODEOWNERS pattern recorded as the reason of the picks.
+func pickFromPools(
+	strategyOf poolStrategy,
+	members map[string][]repo.PoolMember,
+	pools []string,
+	n int,
+	exclude map[string]api.ExcludedCandidateReason,
+	req skillRequirement,
+	rule string,
+) *poolPick {
+	exclude = maps.Clone(exclude)
+	result := &poolPick{
+		picked:      []Candidate{},
+		assignments: []api.ReviewerAssignment{},
+		considered:  []Candidate{},
+		excluded:    []api.ExcludedCandidate{},
+	}
+
+	for _, pool := range pools {
+		if len(result.picked) >= n {
+			break
+		}
+
+		strategy := strategyOf(members[pool])
+		candidates, excluded := collectCandidates(pool, members[pool], exclude, req)
+		result.considered = append(result.considered, candidates...)
+		result.excluded = append(result.excluded, excluded...)
+
+		for _, c := range strategy.Pick(candidates, n-len(result.picked)) {
+			reason := api.AssignmentReason{
+				Strategy: strategy.Name(),
+				PoolSize: len(candidates),
+				Load:     c.OpenReviews,
+			}
+			if rule != "" {
+				reason.Rule = &rule
+			}
+			result.picked = append(result.picked, c)
+			result.assignments = append(result.assignments, toAssignment(c, reason))
+			exclude[c.UserID] = api.AlreadyAssigned
+		}
+	}
+
+	return result
+}
+
+// collectCandidates returns the members of the pool eligible for review together with
+// their current open review load and the required skills they have, and the other members
+// with the reason they are excluded: listed in exclude, inactive, away by an unavailability
+// period, at their open review limit or, when skills are required, without any of them.
+// Members come ordered by user_id so that picks are reproducible for a fixed random seed.
+func collectCandidates(
+	pool string,
+	members []repo.PoolMember,
+	exclude map[string]api.ExcludedCandidateReason,
+	req skillRequirement,
+) (candidates []Candidate, excluded []api.ExcludedCandidate) {
+	excludeMember := func(userID string, reason api.ExcludedCandidateReason) {
+		excluded = append(excluded, api.ExcludedCandidate{UserId: userID, Pool: pool, Reason: reason})
+	}
+
+	candidates = make([]Candidate, 0, len(members))
+	for _, m := range members {
+		if reason, ok := exclude[m.UserID]; ok {
+			excludeMember(m.UserID, reason)
+			continue
+		}
+		switch {
+		case !m.IsActive:
+			excludeMember(m.UserID, api.Inactive)
+			continue
+		case m.Unavailable:
+			excludeMember(m.UserID, api.Unavailable)
+			continue
+		case m.ReviewLimit != nil && m.OpenReviews >= *m.ReviewLimit:
+			excludeMember(m.UserID, api.AtCapacity)
+			continue
+		}
+
+		var matched []string
+		for _, skill := range req.skills {
+			if slices.Contains(m.Skills, skill) {
+				matched = append(matched, skill)
+			}
+		}
+		if req.match == api.Require && len(req.skills) > 0 && len(matched) == 0 {
+			excludeMember(m.UserID, api.MissingSkills)
+			continue
+		}
+
+		candidates = append(candidates, Candidate{
+			UserID:        m.UserID,
+			TeamName:      pool,
+			OpenReviews:   m.OpenReviews,
+			MatchedSkills: matched,
+		})
+	}
+	return candidates, excluded
+}
+
+func candidateIDs(candidates []Candidate) []string {
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
+
+func toAssignment(c Candidate, reason api.AssignmentReason) api.ReviewerAssignment {
+	assignment := api.ReviewerAssignment{
+		UserId: c.UserID,
+		Pool:   c.TeamName,
+		Reason: &reason,
+	}
+	if len(c.MatchedSkills) > 0 {
+		assignment.MatchedSkills = &c.MatchedSkills
+	}
+	return assignment
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// ReadyPR moves a draft PR to OPEN and assigns its reviewers the same way CreatePR does.
+func (s *PRService) ReadyPR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	return s.changeStatus(ctx, "ReadyPR", prID, api.PullRequestStatusOPEN, api.PullRequestStatusDRAFT)
+}
+
+// ClosePR closes a draft or open PR without merging it.
+// Its reviewers are kept but no longer count towards their open reviews.
+func (s *PRService) ClosePR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	return s.changeStatus(ctx, "ClosePR", prID, api.PullRequestStatusCLOSED,
+		api.PullRequestStatusDRAFT, api.PullRequestStatusOPEN)
+}
+
+// ReopenPR moves a closed PR back to OPEN. A PR closed as a draft has no reviewers
+// yet, so they are assigned as for ReadyPR.
+func (s *PRService) ReopenPR(ctx context.Context, prID string) (*api.PullRequest, error) {
+	return s.changeStatus(ctx, "ReopenPR", prID, api.PullRequestStatusOPEN, api.PullRequestStatusCLOSED)
+}
+
+// changeStatus moves a PR to the target status if its current status is one of from.
+// A PR that becomes OPEN without reviewers gets them assigned in the same transaction,
+// and the owning teams left without a reviewer are recorded anew.
+func (s *PRService) changeStatus(
+	ctx context.Context,
+	op string,
+	prID string,
+	to api.PullRequestStatus,
+	from ...api.PullRequestStatus,
+) (_ *api.PullRequest, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx %s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("%s rollback error: %v", op, rbErr)
+			}
+		}
+	}()
+
+	pr, err := s.prs.GetByIDForUpdateTx(ctx, tx, prID)
+	if err != nil {
+		if errors.Is(err, repo.ErrPRNotFound) {
+			return nil, ErrPRNotFound
+		}
+		return nil, err
+	}
+
+	if !slices.Contains(from, pr.Status) {
+		if pr.Status == api.PullRequestStatusMERGED {
+			return nil, ErrPRMerged
+		}
+		return nil, fmt.Errorf("%w: cannot move PR from %s to %s", ErrInvalidState, pr.Status, to)
+	}
+
+	now := time.Now().UTC()
+
+	if to == api.PullRequestStatusOPEN && len(pr.AssignedReviewers) == 0 {
+		plan, err := s.planReviewers(ctx, tx, pr.AuthorId, pr.Repository, pr.ChangedFiles, pr.RequiredSkills, pr.SkillMatch, planAssign)
+		if err != nil {
+			return nil, err
+		}
+		if plan.blocked != nil {
+			return nil, plan.blocked
+		}
+
+		if err := s.prs.AddReviewersTx(ctx, tx, prID, plan.assignments, now); err != nil {
+			if errors.Is(err, repo.ErrUserNotFound) {
+				return nil, ErrUserNotFound
+			}
+			return nil, err
+		}
+		if err := s.prs.SetUnmetOwnersTx(ctx, tx, prID, plan.unmetOwners); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.prs.SetStatusTx(ctx, tx, prID, to, now)
+	if err != nil {
+		if errors.Is(err, repo.ErrPRNotFound) {
+			return nil, ErrPRNotFound
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx %s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+// requireOpen returns an error unless the reviewers of the PR can still be changed or submit reviews.
+func requireOpen(pr *api.PullRequest) error {
+	switch pr.Status {
+	case api.PullRequestStatusOPEN:
+		return nil
+	case api.PullRequestStatusMERGED:
+		return ErrPRMerged
+	default:
+		return fmt.Errorf("%w: PR is %s", ErrInvalidState, pr.Status)
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+func TestApplyReview(t *testing.T) {
+	earlier := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Hour)
+	state := func(s api.ReviewState) *api.ReviewState { return &s }
+
+	tests := []struct {
+		name       string
+		current    *api.ReviewState
+		submitted  api.ReviewState
+		want       api.ReviewState
+		wantUpdate bool
+	}{
+		{"first approval", nil, api.APPROVED, api.APPROVED, true},
+		{"first comment", nil, api.COMMENTED, api.COMMENTED, true},
+		{"comment after comment", state(api.COMMENTED), api.COMMENTED, api.COMMENTED, true},
+		{"approval after comment", state(api.COMMENTED), api.APPROVED, api.APPROVED, true},
+		{"comment keeps approval", state(api.APPROVED), api.COMMENTED, api.APPROVED, false},
+		{"comment keeps requested changes", state(api.CHANGESREQUESTED), api.COMMENTED, api.CHANGESREQUESTED, false},
+		{"approval clears requested changes", state(api.CHANGESREQUESTED), api.APPROVED, api.APPROVED, true},
+		{"changes requested after approval", state(api.APPROVED), api.CHANGESREQUESTED, api.CHANGESREQUESTED, true},
+	}
+
+	for _, tt := range tests {
+		reviewers := []api.ReviewerAssignment{
+			{UserId: "u1", ReviewState: tt.current, ReviewedAt: &earlier},
+			{UserId: "u2"},
+		}
+		applyReview(reviewers, &api.Review{ReviewerId: "u1", State: tt.submitted, SubmittedAt: now})
+
+		if got := reviewers[0].ReviewState; got == nil || *got != tt.want {
+			t.Errorf("%s: state %v, want %s", tt.name, got, tt.want)
+		}
+		if updated := reviewers[0].ReviewedAt.Equal(now); updated != tt.wantUpdate {
+			t.Errorf("%s: reviewed_at %v, want updated %v", tt.name, reviewers[0].ReviewedAt, tt.wantUpdate)
+		}
+		if reviewers[1].ReviewState != nil {
+			t.Errorf("%s: another reviewer got state %s", tt.name, *reviewers[1].ReviewState)
+		}
+	}
+}
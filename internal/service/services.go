@@ -12,10 +12,11 @@ import (
 
 // Services holds all service instances.
 type Services struct {
-	db    *sql.DB
-	Teams *TeamService
-	Users *UserService
-	PRs   *PRService
+	db         *sql.DB
+	Teams      *TeamService
+	Users      *UserService
+	PRs        *PRService
+	CodeOwners *CodeOwnersService
 }
 
 // NewServices creates a new Services instance.
@@ -24,12 +25,17 @@ func NewServices(
 	teamRepo *repo.TeamRepo,
 	userRepo *repo.UserRepository,
 	prRepo *repo.PRRepo,
+	codeOwnersRepo *repo.CodeOwnersRepo,
+	strategies *Strategies,
 ) *Services {
+	prs := NewPRService(db, prRepo, userRepo, teamRepo, codeOwnersRepo, strategies)
+
 	return &Services{
-		db:    db,
-		Teams: NewTeamService(db, teamRepo, userRepo),
-		Users: NewUserService(userRepo, prRepo),
-		PRs:   NewPRService(prRepo, userRepo, teamRepo),
+		db:         db,
+		Teams:      NewTeamService(db, teamRepo, userRepo, prs, codeOwnersRepo),
+		Users:      NewUserService(userRepo, prRepo),
+		PRs:        prs,
+		CodeOwners: NewCodeOwnersService(db, codeOwnersRepo, teamRepo),
 	}
 }
 
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// Names of the built-in assignment strategies.
+const (
+	StrategyFirst       = "first"
+	StrategyRandom      = "random"
+	StrategyRoundRobin  = "round_robin"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyWeighted    = "weighted"
+)
+
+// strategyNames lists the built-in strategies.
+var strategyNames = []string{StrategyFirst, StrategyRandom, StrategyRoundRobin, StrategyLeastLoaded, StrategyWeighted}
+
+// Candidate is a user eligible for review assignment.
+type Candidate struct {
+	UserID      string
+	TeamName    string
+	OpenReviews int
+	// MatchedSkills lists the PR's required skills the candidate has.
+	MatchedSkills []string
+}
+
+// AssignmentStrategy selects reviewers among eligible candidates.
+type AssignmentStrategy interface {
+	// Name returns the name the strategy is configured by.
+	Name() string
+	// Pick returns up to n distinct candidates. Candidates with matched skills are
+	// picked before the others, each group in the strategy's own order.
+	// It must not modify the input slice.
+	Pick(candidates []Candidate, n int) []Candidate
+}
+
+// Rand is a goroutine-safe random generator that can be cloned.
+type Rand struct {
+	*rand.Rand
+	src *lockedSource
+}
+
+// NewRand returns a goroutine-safe random generator seeded with seed.
+// Strategies sharing one generator produce reproducible picks for a fixed seed
+// as long as requests are served in the same order.
+func NewRand(seed uint64) *Rand {
+	return newRand(&lockedSource{src: rand.NewPCG(seed, seed)})
+}
+
+func newRand(src *lockedSource) *Rand {
+	return &Rand{Rand: rand.New(src), src: src}
+}
+
+// Clone returns an independent generator in the current state of r:
+// it yields what r would yield next, without advancing r.
+func (r *Rand) Clone() *Rand {
+	r.src.mu.Lock()
+	pcg := *r.src.src
+	r.src.mu.Unlock()
+	return newRand(&lockedSource{src: &pcg})
+}
+
+type lockedSource struct {
+	mu  sync.Mutex
+	src *rand.PCG
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Uint64()
+}
+
+// NewAssignmentStrategy returns the built-in strategy with the given name.
+// Randomized strategies draw from rng.
+func NewAssignmentStrategy(name string, rng *rand.Rand) (AssignmentStrategy, error) {
+	switch name {
+	case StrategyFirst:
+		return firstStrategy{}, nil
+	case StrategyRandom:
+		return randomStrategy{rng: rng}, nil
+	case StrategyRoundRobin:
+		return &roundRobinStrategy{next: make(map[string]int)}, nil
+	case StrategyLeastLoaded:
+		return leastLoadedStrategy{rng: rng}, nil
+	case StrategyWeighted:
+		return weightedStrategy{rng: rng}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStrategy, name)
+	}
+}
+
+// checkStrategy reports ErrUnknownStrategy unless name is a built-in strategy.
+func checkStrategy(name string) error {
+	if !slices.Contains(strategyNames, name) {
+		return fmt.Errorf("%w: %q", ErrUnknownStrategy, name)
+	}
+	return nil
+}
+
+// Strategies holds one instance of every built-in strategy and resolves the strategy
+// each pool is picked from by: the one chosen by the pool's team, or the server default.
+type Strategies struct {
+	rng      *Rand
+	byName   map[string]AssignmentStrategy
+	assign   AssignmentStrategy
+	reassign AssignmentStrategy
+}
+
+// NewStrategies returns the built-in strategies drawing from rng, with assign and reassign
+// as the defaults for picking new reviewers and replacements in teams without their own.
+func NewStrategies(assign, reassign string, rng *Rand) (*Strategies, error) {
+	s := &Strategies{rng: rng, byName: make(map[string]AssignmentStrategy, len(strategyNames))}
+	for _, name := range strategyNames {
+		strategy, err := NewAssignmentStrategy(name, rng.Rand)
+		if err != nil {
+			return nil, err
+		}
+		s.byName[name] = strategy
+	}
+
+	if err := checkStrategy(assign); err != nil {
+		return nil, err
+	}
+	if err := checkStrategy(reassign); err != nil {
+		return nil, err
+	}
+	s.assign = s.byName[assign]
+	s.reassign = s.byName[reassign]
+	return s, nil
+}
+
+// Clone returns strategies that pick as s would pick next without advancing s:
+// they draw from a clone of the generator and continue from the current round-robin positions.
+// Picks match those of s only while no other request is served in between.
+func (s *Strategies) Clone() *Strategies {
+	rng := s.rng.Clone()
+	c := &Strategies{rng: rng, byName: make(map[string]AssignmentStrategy, len(s.byName))}
+	for name, strategy := range s.byName {
+		if rr, ok := strategy.(*roundRobinStrategy); ok {
+			c.byName[name] = rr.clone()
+			continue
+		}
+		// The names come from strategyNames, so the constructor cannot fail.
+		c.byName[name], _ = NewAssignmentStrategy(name, rng.Rand)
+	}
+	c.assign = c.byName[s.assign.Name()]
+	c.reassign = c.byName[s.reassign.Name()]
+	return c
+}
+
+// Defaults returns the server default strategies for new reviewers and replacements.
+func (s *Strategies) Defaults() (assign, reassign AssignmentStrategy) {
+	return s.assign, s.reassign
+}
+
+// forAssign returns the strategy picking new reviewers from the members of one pool.
+func (s *Strategies) forAssign(members []repo.PoolMember) AssignmentStrategy {
+	if len(members) > 0 {
+		if strategy, ok := s.byName[members[0].AssignmentStrategy]; ok {
+			return strategy
+		}
+	}
+	return s.assign
+}
+
+// forReassign returns the strategy picking replacement reviewers from the members of one pool.
+func (s *Strategies) forReassign(members []repo.PoolMember) AssignmentStrategy {
+	if len(members) > 0 {
+		if strategy, ok := s.byName[members[0].ReassignStrategy]; ok {
+			return strategy
+		}
+	}
+	return s.reassign
+}
+
+// firstStrategy takes candidates in the order they were collected.
+type firstStrategy struct{}
+
+func (firstStrategy) Name() string { return StrategyFirst }
+
+func (firstStrategy) Pick(candidates []Candidate, n int) []Candidate {
+	picked := matchingFirst(candidates)
+	return picked[:min(n, len(picked))]
+}
+
+// randomStrategy takes a uniformly random subset of candidates.
+type randomStrategy struct {
+	rng *rand.Rand
+}
+
+func (randomStrategy) Name() string { return StrategyRandom }
+
+func (s randomStrategy) Pick(candidates []Candidate, n int) []Candidate {
+	picked := matchingFirst(shuffled(s.rng, candidates))
+	return picked[:min(n, len(picked))]
+}
+
+// roundRobinStrategy rotates through the candidates of each team ordered by user_id.
+// The next rotation starts after the last candidate picked, so candidates with
+// matched skills take turns among themselves.
+type roundRobinStrategy struct {
+	mu   sync.Mutex
+	next map[string]int
+}
+
+func (*roundRobinStrategy) Name() string { return StrategyRoundRobin }
+
+// clone returns a strategy that continues from the current positions of s.
+func (s *roundRobinStrategy) clone() *roundRobinStrategy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &roundRobinStrategy{next: maps.Clone(s.next)}
+}
+
+func (s *roundRobinStrategy) Pick(candidates []Candidate, n int) []Candidate {
+	if len(candidates) == 0 {
+		return []Candidate{}
+	}
+
+	ordered := slices.Clone(candidates)
+	slices.SortFunc(ordered, func(a, b Candidate) int {
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+	key := ordered[0].TeamName
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := s.next[key] % len(ordered)
+	offsets := make([]int, 0, len(ordered))
+	for i := range ordered {
+		offsets = append(offsets, i)
+	}
+	slices.SortStableFunc(offsets, func(a, b int) int {
+		return compareMatched(ordered[(start+a)%len(ordered)], ordered[(start+b)%len(ordered)])
+	})
+
+	offsets = offsets[:min(n, len(offsets))]
+	picked := make([]Candidate, 0, len(offsets))
+	last := -1
+	for _, off := range offsets {
+		picked = append(picked, ordered[(start+off)%len(ordered)])
+		last = max(last, off)
+	}
+	s.next[key] = start + last + 1
+	return picked
+}
+
+// leastLoadedStrategy takes the candidates with the fewest open reviews,
+// breaking ties randomly.
+type leastLoadedStrategy struct {
+	rng *rand.Rand
+}
+
+func (leastLoadedStrategy) Name() string { return StrategyLeastLoaded }
+
+func (s leastLoadedStrategy) Pick(candidates []Candidate, n int) []Candidate {
+	picked := shuffled(s.rng, candidates)
+	slices.SortStableFunc(picked, func(a, b Candidate) int {
+		return cmp.Or(compareMatched(a, b), cmp.Compare(a.OpenReviews, b.OpenReviews))
+	})
+	return picked[:min(n, len(picked))]
+}
+
+// weightedStrategy samples candidates randomly with probability
+// inversely proportional to their open reviews.
+type weightedStrategy struct {
+	rng *rand.Rand
+}
+
+func (weightedStrategy) Name() string { return StrategyWeighted }
+
+func (s weightedStrategy) Pick(candidates []Candidate, n int) []Candidate {
+	ordered := matchingFirst(candidates)
+	matched := slices.IndexFunc(ordered, func(c Candidate) bool { return len(c.MatchedSkills) == 0 })
+	if matched < 0 {
+		matched = len(ordered)
+	}
+
+	picked := make([]Candidate, 0, min(n, len(candidates)))
+	picked = s.sample(picked, ordered[:matched], n)
+	return s.sample(picked, ordered[matched:], n)
+}
+
+// sample appends candidates drawn from pool to picked until it holds n of them.
+func (s weightedStrategy) sample(picked, pool []Candidate, n int) []Candidate {
+	pool = slices.Clone(pool)
+	for len(picked) < n && len(pool) > 0 {
+		var total float64
+		for _, c := range pool {
+			total += weight(c)
+		}
+
+		idx := len(pool) - 1
+		r := s.rng.Float64() * total
+		for i, c := range pool {
+			r -= weight(c)
+			if r < 0 {
+				idx = i
+				break
+			}
+		}
+
+		picked = append(picked, pool[idx])
+		pool = slices.Delete(pool, idx, idx+1)
+	}
+	return picked
+}
+
+// compareMatched orders candidates with matched skills before the others.
+func compareMatched(a, b Candidate) int {
+	return cmp.Compare(min(len(b.MatchedSkills), 1), min(len(a.MatchedSkills), 1))
+}
+
+// matchingFirst returns a copy of candidates with those having matched skills moved
+// to the front, keeping the order within each group.
+func matchingFirst(candidates []Candidate) []Candidate {
+	out := slices.Clone(candidates)
+	slices.SortStableFunc(out, compareMatched)
+	return out
+}
+
+func weight(c Candidate) float64 {
+	return 1 / float64(1+c.OpenReviews)
+}
+
+func shuffled(rng *rand.Rand, candidates []Candidate) []Candidate {
+	out := make([]Candidate, len(candidates))
+	copy(out, candidates)
+	rng.Shuffle(len(out), func(i, j int) {
+		out[i], out[j] = out[j], out[i]
+	})
+	return out
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+func candidates(loads ...int) []Candidate {
+	out := make([]Candidate, 0, len(loads))
+	for i, load := range loads {
+		out = append(out, Candidate{UserID: "u" + strconv.Itoa(i+1), TeamName: "backend", OpenReviews: load})
+	}
+	return out
+}
+
+func TestStrategiesPickDistinctCandidates(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want int
+	}{
+		{"fewer than candidates", 2, 2},
+		{"all candidates", 5, 5},
+		{"more than candidates", 8, 5},
+		{"none", 0, 0},
+	}
+
+	for _, name := range strategyNames {
+		for _, tt := range tests {
+			strategy, err := NewAssignmentStrategy(name, NewRand(1).Rand)
+			if err != nil {
+				t.Fatalf("NewAssignmentStrategy(%q): %v", name, err)
+			}
+
+			input := candidates(3, 0, 2, 1, 0)
+			before := slices.Clone(input)
+			picked := strategy.Pick(input, tt.n)
+
+			if len(picked) != tt.want {
+				t.Errorf("%s, %s: picked %d, want %d", name, tt.name, len(picked), tt.want)
+			}
+			ids := candidateIDs(picked)
+			slices.Sort(ids)
+			if len(slices.Compact(ids)) != len(picked) {
+				t.Errorf("%s, %s: repeated picks %v", name, tt.name, candidateIDs(picked))
+			}
+			if !slices.EqualFunc(input, before, func(a, b Candidate) bool { return a.UserID == b.UserID }) {
+				t.Errorf("%s, %s: input reordered", name, tt.name)
+			}
+		}
+	}
+}
+
+func TestStrategiesReproducibleForSeed(t *testing.T) {
+	for _, name := range strategyNames {
+		pick := func() [][]string {
+			strategy, err := NewAssignmentStrategy(name, NewRand(42).Rand)
+			if err != nil {
+				t.Fatalf("NewAssignmentStrategy(%q): %v", name, err)
+			}
+			var rounds [][]string
+			for range 5 {
+				rounds = append(rounds, candidateIDs(strategy.Pick(candidates(1, 1, 1, 1, 1), 2)))
+			}
+			return rounds
+		}
+
+		first, second := pick(), pick()
+		if !slices.EqualFunc(first, second, slices.Equal) {
+			t.Errorf("%s: picks differ for the same seed: %v and %v", name, first, second)
+		}
+	}
+}
+
+func TestFirstStrategy(t *testing.T) {
+	got := candidateIDs(firstStrategy{}.Pick(candidates(3, 0, 2), 2))
+	if want := []string{"u1", "u2"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRoundRobinStrategyRotates(t *testing.T) {
+	strategy := &roundRobinStrategy{next: make(map[string]int)}
+	input := []Candidate{
+		{UserID: "u3", TeamName: "backend"},
+		{UserID: "u1", TeamName: "backend"},
+		{UserID: "u2", TeamName: "backend"},
+	}
+
+	want := [][]string{{"u1", "u2"}, {"u3", "u1"}, {"u2", "u3"}}
+	for i, w := range want {
+		if got := candidateIDs(strategy.Pick(input, 2)); !slices.Equal(got, w) {
+			t.Errorf("round %d: got %v, want %v", i, got, w)
+		}
+	}
+
+	other := []Candidate{{UserID: "u7", TeamName: "frontend"}, {UserID: "u8", TeamName: "frontend"}}
+	if got := candidateIDs(strategy.Pick(other, 1)); !slices.Equal(got, []string{"u7"}) {
+		t.Errorf("another team: got %v, want its own rotation from u7", got)
+	}
+}
+
+func TestLeastLoadedStrategy(t *testing.T) {
+	tests := []struct {
+		loads []int
+		n     int
+		want  []string
+	}{
+		{[]int{3, 0, 2, 1}, 2, []string{"u2", "u4"}},
+		{[]int{5, 4, 3}, 1, []string{"u3"}},
+		{[]int{2, 2, 0}, 1, []string{"u3"}},
+	}
+
+	for _, tt := range tests {
+		strategy := leastLoadedStrategy{rng: NewRand(7).Rand}
+		got := candidateIDs(strategy.Pick(candidates(tt.loads...), tt.n))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("loads %v, n=%d: got %v, want %v", tt.loads, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNewAssignmentStrategyUnknown(t *testing.T) {
+	if _, err := NewAssignmentStrategy("fastest", NewRand(1).Rand); !errors.Is(err, ErrUnknownStrategy) {
+		t.Errorf("got %v, want ErrUnknownStrategy", err)
+	}
+	if _, err := NewStrategies(StrategyRandom, "fastest", NewRand(1)); !errors.Is(err, ErrUnknownStrategy) {
+		t.Errorf("NewStrategies: got %v, want ErrUnknownStrategy", err)
+	}
+}
+
+func TestStrategiesResolvePerTeam(t *testing.T) {
+	strategies, err := NewStrategies(StrategyLeastLoaded, StrategyRandom, NewRand(1))
+	if err != nil {
+		t.Fatalf("NewStrategies: %v", err)
+	}
+
+	tests := []struct {
+		members      []repo.PoolMember
+		wantAssign   string
+		wantReassign string
+	}{
+		{nil, StrategyLeastLoaded, StrategyRandom},
+		{[]repo.PoolMember{{UserID: "u1"}}, StrategyLeastLoaded, StrategyRandom},
+		{[]repo.PoolMember{{UserID: "u1", AssignmentStrategy: StrategyRoundRobin}}, StrategyRoundRobin, StrategyRandom},
+		{[]repo.PoolMember{{UserID: "u1", ReassignStrategy: StrategyFirst}}, StrategyLeastLoaded, StrategyFirst},
+	}
+
+	for i, tt := range tests {
+		if got := strategies.forAssign(tt.members).Name(); got != tt.wantAssign {
+			t.Errorf("case %d: assign strategy %s, want %s", i, got, tt.wantAssign)
+		}
+		if got := strategies.forReassign(tt.members).Name(); got != tt.wantReassign {
+			t.Errorf("case %d: reassign strategy %s, want %s", i, got, tt.wantReassign)
+		}
+	}
+}
+
+func TestStrategiesCloneDoesNotAdvance(t *testing.T) {
+	strategies, err := NewStrategies(StrategyRandom, StrategyRandom, NewRand(9))
+	if err != nil {
+		t.Fatalf("NewStrategies: %v", err)
+	}
+	rr := []repo.PoolMember{{UserID: "u1", AssignmentStrategy: StrategyRoundRobin}}
+
+	for _, members := range [][]repo.PoolMember{nil, rr} {
+		input := candidates(0, 0, 0, 0, 0)
+
+		preview := candidateIDs(strategies.Clone().forAssign(members).Pick(input, 2))
+		again := candidateIDs(strategies.Clone().forAssign(members).Pick(input, 2))
+		actual := candidateIDs(strategies.forAssign(members).Pick(input, 2))
+
+		if !slices.Equal(preview, again) || !slices.Equal(preview, actual) {
+			t.Errorf("%s: clones picked %v and %v, the strategy itself %v",
+				strategies.forAssign(members).Name(), preview, again, actual)
+		}
+	}
+}
+
+func TestStrategiesPickMatchedSkillsFirst(t *testing.T) {
+	for _, name := range strategyNames {
+		strategy, err := NewAssignmentStrategy(name, NewRand(3).Rand)
+		if err != nil {
+			t.Fatalf("NewAssignmentStrategy(%q): %v", name, err)
+		}
+
+		input := candidates(0, 4, 0, 5, 0)
+		input[1].MatchedSkills = []string{"go"}
+		input[3].MatchedSkills = []string{"sql"}
+
+		got := candidateIDs(strategy.Pick(input, 3))
+		if len(got) != 3 {
+			t.Fatalf("%s: picked %v, want 3", name, got)
+		}
+		if matched := got[:2]; !slices.Contains(matched, "u2") || !slices.Contains(matched, "u4") {
+			t.Errorf("%s: picked %v, want u2 and u4 first", name, got)
+		}
+	}
+}
+
+func TestRoundRobinStrategyRotatesWithSkills(t *testing.T) {
+	strategy := &roundRobinStrategy{next: make(map[string]int)}
+	input := candidates(0, 0, 0, 0)
+	input[1].MatchedSkills = []string{"go"}
+	input[3].MatchedSkills = []string{"go"}
+
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{1, []string{"u2"}},
+		{1, []string{"u4"}},
+		{1, []string{"u2"}},
+		{3, []string{"u4", "u2", "u3"}},
+		{1, []string{"u4"}},
+	}
+
+	for i, tt := range tests {
+		if got := candidateIDs(strategy.Pick(input, tt.n)); !slices.Equal(got, tt.want) {
+			t.Errorf("round %d: got %v, want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestRandCloneDoesNotAdvance(t *testing.T) {
+	rng := NewRand(5)
+	rng.Uint64()
+
+	clone := rng.Clone()
+	want := []uint64{clone.Uint64(), clone.Uint64(), clone.Uint64()}
+
+	if again := rng.Clone(); again.Uint64() != want[0] {
+		t.Errorf("second clone diverged from the first")
+	}
+	for i, w := range want {
+		if got := rng.Uint64(); got != w {
+			t.Errorf("draw %d: generator yields %d after cloning, clone yielded %d", i, got, w)
+		}
+	}
+}
+
+func TestStrategiesClonePicksAsNext(t *testing.T) {
+	for _, name := range strategyNames {
+		strategies, err := NewStrategies(name, name, NewRand(11))
+		if err != nil {
+			t.Fatalf("NewStrategies(%q): %v", name, err)
+		}
+		input := candidates(2, 0, 1, 0, 3)
+
+		for round := range 3 {
+			preview := candidateIDs(strategies.Clone().forAssign(nil).Pick(input, 2))
+			actual := candidateIDs(strategies.forAssign(nil).Pick(input, 2))
+			if !slices.Equal(preview, actual) {
+				t.Errorf("%s, round %d: clone picked %v, the strategy itself %v", name, round, preview, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// DeleteResult describes a deleted team.
+type DeleteResult struct {
+	TeamName string
+	// RemovedMembers lists the former members, who are left without a team.
+	RemovedMembers []string
+	Reassigned     []api.ReviewHandoff
+}
+
+// ArchiveTeam archives a team: its members are deactivated and their open reviews are
+// handed over as on removal from the team, and the team is dropped from CODEOWNERS rules.
+// The members and history are kept, while the team is no longer used as a reviewer pool,
+// including as a fallback of other teams.
+func (s *TeamService) ArchiveTeam(ctx context.Context, teamName string) (_ *MembershipResult, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx ArchiveTeam: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("ArchiveTeam rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	if err = s.lockTeam(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	members, err := s.teams.GetMemberIDsTx(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// The PRs the members review are locked before the members are deactivated,
+	// as in removeMembersTx.
+	if err = s.prs.lockOpenReviewsTx(ctx, tx, members); err != nil {
+		return nil, err
+	}
+
+	if err = s.teams.ArchiveTeamTx(ctx, tx, teamName, time.Now().UTC()); err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if err = s.users.DeactivateTeamMembersTx(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	// An archived team has no candidates, so as an owner it would leave the paths it owns
+	// without an owner reviewer; the paths are left to the other owners of the rules.
+	if err = s.codeOwners.RemoveOwnerTx(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	reassigned := []api.ReviewHandoff{}
+	for _, id := range members {
+		handoffs, err := s.prs.handOverReviewsTx(ctx, tx, id, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("hand over reviews of %s: %w", id, err)
+		}
+		reassigned = append(reassigned, handoffs...)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx ArchiveTeam: %w", err)
+	}
+
+	return s.membershipResult(ctx, teamName, reassigned)
+}
+
+// DeleteTeam deletes a team, archived or not, that has no PRs: no PR was created while
+// its author was in the team. PRs the team only reviewed do not count.
+// The members are removed as by RemoveMembers, the team is dropped from CODEOWNERS
+// rules, and its settings and fallback pools are deleted with it.
+func (s *TeamService) DeleteTeam(ctx context.Context, teamName string) (_ *DeleteResult, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx DeleteTeam: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("DeleteTeam rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	if _, err = s.lockTeamTx(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	prCount, err := s.prs.countTeamPRsTx(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if prCount > 0 {
+		return nil, fmt.Errorf("%w: %s has %d", ErrTeamHasPRs, teamName, prCount)
+	}
+
+	members, err := s.teams.GetMemberIDsTx(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	reassigned, err := s.removeMembersTx(ctx, tx, teamName, members)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.codeOwners.RemoveOwnerTx(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	if err = s.teams.DeleteTeamTx(ctx, tx, teamName); err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx DeleteTeam: %w", err)
+	}
+
+	return &DeleteResult{TeamName: teamName, RemovedMembers: members, Reassigned: reassigned}, nil
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// MembershipResult describes a team after its members were removed or replaced.
+type MembershipResult struct {
+	Team *api.Team
+	// Reassigned lists the open reviews of removed members and who took them over.
+	Reassigned []api.ReviewHandoff
+}
+
+// AddMembers creates or updates users and makes them members of an existing team.
+func (s *TeamService) AddMembers(ctx context.Context, body *api.PostTeamAddMembersJSONBody) (_ *api.Team, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx AddMembers: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("AddMembers rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	if err = s.lockTeam(ctx, tx, body.TeamName); err != nil {
+		return nil, err
+	}
+
+	if err = s.upsertMembersTx(ctx, tx, body.TeamName, body.Members); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx AddMembers: %w", err)
+	}
+
+	return s.GetTeam(ctx, body.TeamName)
+}
+
+// RemoveMembers removes users from a team, leaving them without a team.
+// Their open reviews are handed over in the same transaction.
+func (s *TeamService) RemoveMembers(ctx context.Context, body *api.PostTeamRemoveMembersJSONBody) (_ *MembershipResult, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx RemoveMembers: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("RemoveMembers rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	if err = s.lockTeam(ctx, tx, body.TeamName); err != nil {
+		return nil, err
+	}
+
+	current, err := s.teams.GetMemberIDsTx(ctx, tx, body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range body.UserIds {
+		if !slices.Contains(current, id) {
+			return nil, fmt.Errorf("%w: %s is not in team %s", ErrNotTeamMember, id, body.TeamName)
+		}
+	}
+
+	reassigned, err := s.removeMembersTx(ctx, tx, body.TeamName, body.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx RemoveMembers: %w", err)
+	}
+
+	return s.membershipResult(ctx, body.TeamName, reassigned)
+}
+
+// UpdateTeam replaces the members of a team: the given members are created or updated,
+// the other current members are removed as by RemoveMembers.
+func (s *TeamService) UpdateTeam(ctx context.Context, team *api.Team) (_ *MembershipResult, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx UpdateTeam: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("UpdateTeam rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	if err = s.lockTeam(ctx, tx, team.TeamName); err != nil {
+		return nil, err
+	}
+
+	current, err := s.teams.GetMemberIDsTx(ctx, tx, team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.upsertMembersTx(ctx, tx, team.TeamName, team.Members); err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+	for _, id := range current {
+		if !slices.ContainsFunc(team.Members, func(m api.TeamMember) bool { return m.UserId == id }) {
+			removed = append(removed, id)
+		}
+	}
+
+	reassigned, err := s.removeMembersTx(ctx, tx, team.TeamName, removed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx UpdateTeam: %w", err)
+	}
+
+	return s.membershipResult(ctx, team.TeamName, reassigned)
+}
+
+// lockTeam locks a team whose membership is about to change; archived teams are rejected.
+func (s *TeamService) lockTeam(ctx context.Context, tx *sql.Tx, teamName string) error {
+	archived, err := s.lockTeamTx(ctx, tx, teamName)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return fmt.Errorf("%w: %s", ErrTeamArchived, teamName)
+	}
+	return nil
+}
+
+func (s *TeamService) lockTeamTx(ctx context.Context, tx *sql.Tx, teamName string) (bool, error) {
+	archived, err := s.teams.LockTeamTx(ctx, tx, teamName)
+	if err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return false, ErrTeamNotFound
+		}
+		return false, err
+	}
+	return archived, nil
+}
+
+func (s *TeamService) upsertMembersTx(ctx context.Context, tx *sql.Tx, teamName string, members []api.TeamMember) error {
+	for _, member := range members {
+		u := &api.User{
+			UserId:   member.UserId,
+			Username: member.Username,
+			TeamName: teamName,
+			IsActive: member.IsActive,
+		}
+
+		if err := s.users.InsertOrUpdateTx(ctx, tx, u); err != nil {
+			if errors.Is(err, repo.ErrUserInOtherTeam) {
+				return fmt.Errorf("%w: %s, use /users/transfer", ErrUserInOtherTeam, member.UserId)
+			}
+			return fmt.Errorf("team %s: user %s: %w", teamName, member.UserId, err)
+		}
+	}
+	return nil
+}
+
+// removeMembersTx detaches the users from the team first, so that they are not
+// picked for each other's reviews, and then hands their open reviews over.
+// The PRs they review are locked before the users are updated.
+func (s *TeamService) removeMembersTx(ctx context.Context, tx *sql.Tx, teamName string, userIDs []string) ([]api.ReviewHandoff, error) {
+	reassigned := []api.ReviewHandoff{}
+	if len(userIDs) == 0 {
+		return reassigned, nil
+	}
+
+	if err := s.prs.lockOpenReviewsTx(ctx, tx, userIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.users.RemoveFromTeamTx(ctx, tx, teamName, userIDs); err != nil {
+		return nil, err
+	}
+
+	for _, id := range userIDs {
+		handoffs, err := s.prs.handOverReviewsTx(ctx, tx, id, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("hand over reviews of %s: %w", id, err)
+		}
+		reassigned = append(reassigned, handoffs...)
+	}
+	return reassigned, nil
+}
+
+func (s *TeamService) membershipResult(ctx context.Context, teamName string, reassigned []api.ReviewHandoff) (*MembershipResult, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return &MembershipResult{Team: team, Reassigned: reassigned}, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// RenameTeam renames a team. Members, settings, fallback pools, including the pools
+// of other teams that fall back to it, and the author team of its PRs follow through
+// ON UPDATE CASCADE; CODEOWNERS rules are updated in the same transaction.
+// Logs keep the old name.
+func (s *TeamService) RenameTeam(ctx context.Context, body *api.PostTeamRenameJSONBody) (_ *api.Team, err error) {
+	if strings.TrimSpace(body.NewTeamName) == "" {
+		return nil, fmt.Errorf("%w: new_team_name is empty", ErrInvalidTeamName)
+	}
+	if body.NewTeamName == body.TeamName {
+		return nil, fmt.Errorf("%w: team %s already has this name", ErrInvalidTeamName, body.TeamName)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx RenameTeam: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("RenameTeam rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	if _, err = s.lockTeamTx(ctx, tx, body.TeamName); err != nil {
+		return nil, err
+	}
+
+	if err = s.teams.RenameTeamTx(ctx, tx, body.TeamName, body.NewTeamName); err != nil {
+		switch {
+		case errors.Is(err, repo.ErrTeamExists):
+			return nil, ErrTeamAlreadyExists
+		case errors.Is(err, repo.ErrTeamNotFound):
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if err = s.codeOwners.RenameOwnerTx(ctx, tx, body.TeamName, body.NewTeamName); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx RenameTeam: %w", err)
+	}
+
+	return s.getTeam(ctx, body.NewTeamName)
+}
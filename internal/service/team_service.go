@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 
 	"ilyaytrewq/PR_assigning_service/internal/api"
 	"ilyaytrewq/PR_assigning_service/internal/repo"
@@ -16,14 +17,25 @@ type TeamService struct {
 	db    *sql.DB
 	teams *repo.TeamRepo
 	users *repo.UserRepository
+	prs   *PRService
+
+	codeOwners *repo.CodeOwnersRepo
 }
 
 // NewTeamService creates a new TeamService instance.
-func NewTeamService(db *sql.DB, teams *repo.TeamRepo, users *repo.UserRepository) *TeamService {
-	return &TeamService{db: db, teams: teams, users: users}
+// prs hands over the open reviews of removed members.
+func NewTeamService(
+	db *sql.DB,
+	teams *repo.TeamRepo,
+	users *repo.UserRepository,
+	prs *PRService,
+	codeOwners *repo.CodeOwnersRepo,
+) *TeamService {
+	return &TeamService{db: db, teams: teams, users: users, prs: prs, codeOwners: codeOwners}
 }
 
 // AddTeam creates a new team and its members.
+// Members that already belong to another team are rejected with ErrUserInOtherTeam.
 func (s *TeamService) AddTeam(ctx context.Context, team *api.Team) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -44,17 +56,8 @@ func (s *TeamService) AddTeam(ctx context.Context, team *api.Team) error {
 		return err
 	}
 
-	for _, member := range team.Members {
-		u := &api.User{
-			UserId:   member.UserId,
-			Username: member.Username,
-			TeamName: team.TeamName,
-			IsActive: member.IsActive,
-		}
-
-		if err = s.users.InsertOrUpdateTx(ctx, tx, u); err != nil {
-			return fmt.Errorf("add team %s: user %s: %w", team.TeamName, member.UserId, err)
-		}
+	if err = s.upsertMembersTx(ctx, tx, team.TeamName, team.Members); err != nil {
+		return err
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -66,6 +69,19 @@ func (s *TeamService) AddTeam(ctx context.Context, team *api.Team) error {
 
 // GetTeam retrieves a team by name.
 func (s *TeamService) GetTeam(ctx context.Context, teamName string) (*api.Team, error) {
+	team, err := s.getTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	// Archived teams are hidden, as if they were deleted.
+	if team.ArchivedAt != nil {
+		return nil, ErrTeamNotFound
+	}
+	return team, nil
+}
+
+// getTeam returns a team, archived or not.
+func (s *TeamService) getTeam(ctx context.Context, teamName string) (*api.Team, error) {
 	team, err := s.teams.GetTeam(ctx, teamName)
 	if err != nil {
 		if errors.Is(err, repo.ErrTeamNotFound) {
@@ -76,6 +92,123 @@ func (s *TeamService) GetTeam(ctx context.Context, teamName string) (*api.Team,
 	return team, nil
 }
 
+// GetSettings retrieves the reviewer assignment settings of a team.
+func (s *TeamService) GetSettings(ctx context.Context, teamName string) (*api.TeamSettings, error) {
+	settings, err := s.teams.GetSettings(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateSettings applies the provided fields on top of the current team settings.
+// The settings are read with the team row locked and written in the same transaction,
+// so concurrent partial updates of one team do not overwrite each other.
+func (s *TeamService) UpdateSettings(ctx context.Context, body *api.PostTeamSetSettingsJSONBody) (_ *api.TeamSettings, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx UpdateSettings: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("UpdateSettings rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	settings, err := s.teams.GetSettingsForUpdateTx(ctx, tx, body.TeamName)
+	if err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if body.ReviewersCount != nil {
+		if *body.ReviewersCount < 0 {
+			return nil, fmt.Errorf("%w: reviewers_count must not be negative", ErrInvalidTeamSettings)
+		}
+		settings.ReviewersCount = *body.ReviewersCount
+	}
+
+	if body.MaxOpenReviews != nil {
+		switch limit := *body.MaxOpenReviews; {
+		case limit < 0:
+			return nil, fmt.Errorf("%w: max_open_reviews must not be negative", ErrInvalidTeamSettings)
+		case limit == 0:
+			settings.MaxOpenReviews = nil
+		default:
+			settings.MaxOpenReviews = &limit
+		}
+	}
+
+	if body.RequiredApprovals != nil {
+		if *body.RequiredApprovals < 0 {
+			return nil, fmt.Errorf("%w: required_approvals must not be negative", ErrInvalidTeamSettings)
+		}
+		settings.RequiredApprovals = *body.RequiredApprovals
+	}
+
+	if body.BlockOnChangesRequested != nil {
+		settings.BlockOnChangesRequested = *body.BlockOnChangesRequested
+	}
+
+	if body.AssignmentStrategy != nil {
+		settings.AssignmentStrategy, err = teamStrategy(*body.AssignmentStrategy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if body.ReassignStrategy != nil {
+		settings.ReassignStrategy, err = teamStrategy(*body.ReassignStrategy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if body.FallbackTeams != nil {
+		fallbacks := []string{}
+		for _, fallback := range *body.FallbackTeams {
+			if fallback == settings.TeamName {
+				return nil, fmt.Errorf("%w: team cannot be its own fallback", ErrInvalidTeamSettings)
+			}
+			if !slices.Contains(fallbacks, fallback) {
+				fallbacks = append(fallbacks, fallback)
+			}
+		}
+		settings.FallbackTeams = fallbacks
+	}
+
+	if err = s.teams.UpsertSettingsTx(ctx, tx, settings); err != nil {
+		if errors.Is(err, repo.ErrTeamNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx UpdateSettings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// teamStrategy validates the strategy a team chooses; an empty name resets it to the server default.
+func teamStrategy(name string) (*string, error) {
+	if name == "" {
+		return nil, nil
+	}
+	if err := checkStrategy(name); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTeamSettings, err)
+	}
+	return &name, nil
+}
+
 // CountTeams returns the total number of teams.
 func (s *TeamService) CountTeams(ctx context.Context) (int, error) {
 	count, err := s.teams.CountTeams(ctx)
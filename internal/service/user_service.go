@@ -3,6 +3,10 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"ilyaytrewq/PR_assigning_service/internal/api"
 	"ilyaytrewq/PR_assigning_service/internal/repo"
@@ -31,16 +35,308 @@ func (s *UserService) SetIsActive(ctx context.Context, userID string, isActive b
 	return user, nil
 }
 
-// GetReviewPullRequests retrieves PRs assigned to a reviewer.
-func (s *UserService) GetReviewPullRequests(ctx context.Context, userID string) ([]*api.PullRequest, error) {
-	prs, err := s.prs.GetByReviewer(ctx, userID)
+// ReviewPage is a page of PRs assigned to a reviewer.
+type ReviewPage struct {
+	PRPage
+	// PendingCount is the number of open PRs still awaiting the reviewer's decision.
+	PendingCount int
+}
+
+// GetReviewPullRequests retrieves a page of PRs where the user is a current reviewer,
+// open ones first unless another sort is requested.
+func (s *UserService) GetReviewPullRequests(ctx context.Context, params *api.GetUsersGetReviewParams) (*ReviewPage, error) {
+	userID := string(params.UserId)
+	filter := repo.PRFilter{ReviewerID: &userID, OpenFirst: true}
+
+	var err error
+	if params.Status != nil {
+		if filter.Status, err = listStatus(string(*params.Status)); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.Sort != nil {
+		if err = applyOpenFirstSort(&filter, string(*params.Sort)); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := listPage(ctx, s.prs, filter, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.prs.CountPendingReviews(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReviewPage{PRPage: *page, PendingCount: pending}, nil
+}
+
+// AuthoredPage is a page of PRs of an author with the reviewers they wait on.
+type AuthoredPage struct {
+	PullRequests []api.AuthoredPullRequest
+	// NextCursor is nil on the last page.
+	NextCursor *string
+}
+
+// GetAuthoredPullRequests retrieves a page of PRs authored by the user, open ones first
+// unless another sort is requested. Every open PR lists the reviewers whose latest
+// decision is neither APPROVED nor CHANGES_REQUESTED, longest waiting first.
+func (s *UserService) GetAuthoredPullRequests(ctx context.Context, params *api.GetUsersGetAuthoredParams) (*AuthoredPage, error) {
+	userID := string(params.UserId)
+	if _, err := s.users.Get(ctx, userID); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	filter := repo.PRFilter{AuthorID: &userID, OpenFirst: true}
+
+	var err error
+	if params.Status != nil {
+		if filter.Status, err = listStatus(string(*params.Status)); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.Sort != nil {
+		if err = applyOpenFirstSort(&filter, string(*params.Sort)); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := listPage(ctx, s.prs, filter, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	authored := &AuthoredPage{
+		PullRequests: make([]api.AuthoredPullRequest, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for _, pr := range page.PullRequests {
+		authored.PullRequests = append(authored.PullRequests, api.AuthoredPullRequest{
+			Pr:        *pr,
+			WaitingOn: waitingOn(pr, now),
+		})
+	}
+	return authored, nil
+}
+
+// waitingOn returns the reviewers an open PR still waits on, longest waiting first:
+// those without a decision in the current assignment, the same as CountPendingReviews counts.
+func waitingOn(pr *api.PullRequest, now time.Time) []api.ReviewerWait {
+	waits := []api.ReviewerWait{}
+	if pr.Status != api.PullRequestStatusOPEN || pr.Reviewers == nil {
+		return waits
+	}
+
+	for _, reviewer := range *pr.Reviewers {
+		if reviewer.ReviewState != nil && *reviewer.ReviewState != api.COMMENTED {
+			continue
+		}
+
+		since := now
+		if reviewer.AssignedAt != nil {
+			since = *reviewer.AssignedAt
+		}
+
+		waits = append(waits, api.ReviewerWait{
+			UserId:         reviewer.UserId,
+			ReviewState:    reviewer.ReviewState,
+			Since:          since,
+			WaitingSeconds: int64(now.Sub(since) / time.Second),
+		})
+	}
+
+	slices.SortStableFunc(waits, func(a, b api.ReviewerWait) int {
+		return a.Since.Compare(b.Since)
+	})
+	return waits
+}
+
+// GetReviewLoad returns the number of open reviews of a user against the effective limit.
+func (s *UserService) GetReviewLoad(ctx context.Context, userID string) (*api.ReviewLoad, error) {
+	if _, err := s.users.Get(ctx, userID); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	loads, err := s.prs.GetOpenReviewCounts(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := s.users.GetReviewLimits(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	load := &api.ReviewLoad{
+		UserId:      userID,
+		OpenReviews: loads[userID],
+	}
+	if limit, ok := limits[userID]; ok {
+		load.MaxOpenReviews = &limit
+		load.AtCapacity = load.OpenReviews >= limit
+	}
+	return load, nil
+}
+
+// SetMaxOpenReviews sets the personal open review limit of a user; 0 removes it.
+func (s *UserService) SetMaxOpenReviews(ctx context.Context, userID string, limit int) (*api.ReviewLoad, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("%w: max_open_reviews must not be negative", ErrInvalidReviewLimit)
+	}
+
+	var personal *int
+	if limit > 0 {
+		personal = &limit
+	}
+
+	if err := s.users.SetMaxOpenReviews(ctx, userID, personal); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return s.GetReviewLoad(ctx, userID)
+}
+
+// AddUnavailability records a period when the user must not be assigned reviews.
+func (s *UserService) AddUnavailability(ctx context.Context, body *api.PostUsersAddUnavailabilityJSONBody) (*api.UserUnavailability, error) {
+	if !body.EndsAt.After(body.StartsAt) {
+		return nil, fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidUnavailability)
+	}
+
+	period := &api.Unavailability{
+		UserId:   body.UserId,
+		StartsAt: body.StartsAt.UTC(),
+		EndsAt:   body.EndsAt.UTC(),
+	}
+	if body.Reason != nil {
+		period.Reason = *body.Reason
+	}
+
+	if err := s.users.InsertUnavailability(ctx, period); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return s.GetUnavailability(ctx, body.UserId)
+}
+
+// GetUnavailability returns the current and upcoming unavailability periods of a user.
+func (s *UserService) GetUnavailability(ctx context.Context, userID string) (*api.UserUnavailability, error) {
+	if _, err := s.users.Get(ctx, userID); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	periods, err := s.users.GetUnavailability(ctx, userID, time.Now().UTC())
 	if err != nil {
 		return nil, err
 	}
-	return prs, nil
+	return &api.UserUnavailability{UserId: userID, Periods: periods}, nil
+}
+
+// RemoveUnavailability deletes an unavailability period of a user.
+func (s *UserService) RemoveUnavailability(ctx context.Context, userID string, id int64) (*api.UserUnavailability, error) {
+	if err := s.users.DeleteUnavailability(ctx, userID, id); err != nil {
+		if errors.Is(err, repo.ErrUnavailabilityNotFound) {
+			return nil, ErrUnavailabilityNotFound
+		}
+		return nil, err
+	}
+	return s.GetUnavailability(ctx, userID)
+}
+
+// GetSkills returns the skills of a user.
+func (s *UserService) GetSkills(ctx context.Context, userID string) (*api.UserSkills, error) {
+	if _, err := s.users.Get(ctx, userID); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	skills, err := s.users.GetSkills(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UserSkills{UserId: userID, Skills: skills}, nil
+}
+
+// AddSkills adds skills to a user.
+func (s *UserService) AddSkills(ctx context.Context, body *api.UserSkillsRequest) (*api.UserSkills, error) {
+	skills, err := normalizeSkills(body.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.users.AddSkills(ctx, body.UserId, skills); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return s.GetSkills(ctx, body.UserId)
+}
+
+// RemoveSkills removes skills from a user.
+func (s *UserService) RemoveSkills(ctx context.Context, body *api.UserSkillsRequest) (*api.UserSkills, error) {
+	skills, err := normalizeSkills(body.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.users.RemoveSkills(ctx, body.UserId, skills); err != nil {
+		return nil, err
+	}
+	return s.GetSkills(ctx, body.UserId)
+}
+
+// SetSkills replaces the skills of a user.
+func (s *UserService) SetSkills(ctx context.Context, body *api.UserSkillsRequest) (*api.UserSkills, error) {
+	skills, err := normalizeSkills(body.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.users.SetSkills(ctx, body.UserId, skills); err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return s.GetSkills(ctx, body.UserId)
 }
 
 // GetCountUsers returns user statistics.
 func (s *UserService) GetCountUsers(ctx context.Context) (total int, active int, err error) {
 	return s.users.CountUsersAndActive(ctx)
 }
+
+// normalizeSkills lowercases and deduplicates skills and rejects empty ones.
+func normalizeSkills(skills []string) ([]string, error) {
+	normalized := []string{}
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.TrimSpace(skill))
+		if skill == "" {
+			return nil, fmt.Errorf("%w: skill must not be empty", ErrInvalidSkills)
+		}
+		if !slices.Contains(normalized, skill) {
+			normalized = append(normalized, skill)
+		}
+	}
+	return normalized, nil
+}
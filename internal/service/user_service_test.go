@@ -0,0 +1,59 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+)
+
+func TestWaitingOn(t *testing.T) {
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time { at := now.Add(-d); return &at }
+	state := func(s api.ReviewState) *api.ReviewState { return &s }
+
+	reviewers := []api.ReviewerAssignment{
+		{UserId: "approved", AssignedAt: ago(3 * time.Hour), ReviewState: state(api.APPROVED)},
+		{UserId: "recent", AssignedAt: ago(time.Hour)},
+		{UserId: "commented", AssignedAt: ago(2 * time.Hour), ReviewState: state(api.COMMENTED)},
+		{UserId: "changes", AssignedAt: ago(4 * time.Hour), ReviewState: state(api.CHANGESREQUESTED)},
+		{UserId: "unknown"},
+	}
+
+	tests := []struct {
+		name      string
+		status    api.PullRequestStatus
+		reviewers *[]api.ReviewerAssignment
+		want      []string
+	}{
+		{"open", api.PullRequestStatusOPEN, &reviewers, []string{"commented", "recent", "unknown"}},
+		{"no reviewers", api.PullRequestStatusOPEN, nil, []string{}},
+		{"draft", api.PullRequestStatusDRAFT, &reviewers, []string{}},
+		{"merged", api.PullRequestStatusMERGED, &reviewers, []string{}},
+		{"closed", api.PullRequestStatusCLOSED, &reviewers, []string{}},
+	}
+
+	for _, tt := range tests {
+		waits := waitingOn(&api.PullRequest{Status: tt.status, Reviewers: tt.reviewers}, now)
+
+		got := make([]string, 0, len(waits))
+		for _, w := range waits {
+			got = append(got, w.UserId)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: waiting on %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	waits := waitingOn(&api.PullRequest{Status: api.PullRequestStatusOPEN, Reviewers: &reviewers}, now)
+	wantSeconds := []int64{7200, 3600, 0}
+	for i, w := range waits {
+		if w.WaitingSeconds != wantSeconds[i] {
+			t.Errorf("%s: waiting %ds, want %d", w.UserId, w.WaitingSeconds, wantSeconds[i])
+		}
+	}
+	if waits[0].ReviewState == nil || *waits[0].ReviewState != api.COMMENTED {
+		t.Errorf("commented: review state %v, want COMMENTED", waits[0].ReviewState)
+	}
+}
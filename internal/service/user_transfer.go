@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"ilyaytrewq/PR_assigning_service/internal/api"
+	"ilyaytrewq/PR_assigning_service/internal/repo"
+)
+
+// TransferResult describes a completed user transfer.
+type TransferResult struct {
+	User       *api.User
+	Transfer   *api.UserTransfer
+	Reassigned []api.ReviewHandoff
+}
+
+// TransferUser moves a user to another team and records the transfer.
+// With the reassign mode (the default) the user's open reviews are handed over within
+// the old team as on removal from it; with keep the user stays their reviewer.
+// Both teams are locked in name order, then the PRs the user reviews and the user row;
+// the target team must not be archived.
+func (s *TeamService) TransferUser(ctx context.Context, body *api.PostUsersTransferJSONBody) (_ *TransferResult, err error) {
+	mode := api.TransferReviewsReassign
+	if body.Reviews != nil {
+		switch *body.Reviews {
+		case api.TransferReviewsReassign, api.TransferReviewsKeep:
+			mode = *body.Reviews
+		default:
+			return nil, fmt.Errorf("%w: unknown reviews mode %q", ErrInvalidTransfer, *body.Reviews)
+		}
+	}
+
+	current, err := s.users.Get(ctx, body.UserId)
+	if err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if current.TeamName == body.TeamName {
+		return nil, fmt.Errorf("%w: %s is already in team %s", ErrInvalidTransfer, body.UserId, body.TeamName)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx TransferUser: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Printf("TransferUser rollback error: %v", rbErr)
+			}
+		}
+	}()
+
+	teams := []string{body.TeamName}
+	if current.TeamName != "" {
+		teams = append(teams, current.TeamName)
+	}
+	slices.Sort(teams)
+	for _, team := range teams {
+		archived, err := s.lockTeamTx(ctx, tx, team)
+		if err != nil {
+			return nil, err
+		}
+		// Members may still be moved out of an archived team, but not into one.
+		if archived && team == body.TeamName {
+			return nil, fmt.Errorf("%w: %s", ErrTeamArchived, team)
+		}
+	}
+
+	// The PRs whose reviews are handed over are locked before the user row, as in removeMembersTx.
+	if mode == api.TransferReviewsReassign {
+		if err = s.prs.lockOpenReviewsTx(ctx, tx, []string{body.UserId}); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.users.GetForUpdateTx(ctx, tx, body.UserId)
+	if err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if user.TeamName != current.TeamName {
+		return nil, fmt.Errorf("%w: team of %s changed concurrently, retry", ErrInvalidTransfer, body.UserId)
+	}
+
+	reassigned := []api.ReviewHandoff{}
+	if mode == api.TransferReviewsReassign && user.TeamName != "" {
+		if reassigned, err = s.removeMembersTx(ctx, tx, user.TeamName, []string{user.UserId}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.users.SetTeamTx(ctx, tx, user.UserId, body.TeamName); err != nil {
+		switch {
+		case errors.Is(err, repo.ErrTeamNotFound):
+			return nil, ErrTeamNotFound
+		case errors.Is(err, repo.ErrUserNotFound):
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	transfer := &api.UserTransfer{
+		UserId:        user.UserId,
+		ToTeam:        body.TeamName,
+		Reviews:       mode,
+		Reassigned:    len(reassigned),
+		TransferredAt: time.Now().UTC(),
+	}
+	if user.TeamName != "" {
+		from := user.TeamName
+		transfer.FromTeam = &from
+	}
+
+	if err = s.users.InsertTransferTx(ctx, tx, transfer); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx TransferUser: %w", err)
+	}
+
+	user.TeamName = body.TeamName
+	return &TransferResult{User: user, Transfer: transfer, Reassigned: reassigned}, nil
+}
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name       TEXT PRIMARY KEY REFERENCES teams(team_name)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    reviewers_count INTEGER NOT NULL DEFAULT 2 CHECK (reviewers_count >= 0)
+);
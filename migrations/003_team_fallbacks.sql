@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name          TEXT NOT NULL REFERENCES teams(team_name)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    fallback_team_name TEXT NOT NULL REFERENCES teams(team_name)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    priority           INTEGER NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS reviewer_assignments JSONB NOT NULL DEFAULT '{}';
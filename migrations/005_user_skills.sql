@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id TEXT NOT NULL REFERENCES users(user_id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    skill   TEXT NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS required_skills TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS skill_match     TEXT NOT NULL DEFAULT 'prefer'
+        CHECK (skill_match IN ('prefer', 'require'));
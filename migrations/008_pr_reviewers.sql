@@ -0,0 +1,75 @@
+CREATE TABLE IF NOT EXISTS pr_reviewers (
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    user_id         TEXT NOT NULL REFERENCES users(user_id)
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    position        INTEGER NOT NULL,
+    pool            TEXT NOT NULL DEFAULT '',
+    matched_skills  TEXT[] NOT NULL DEFAULT '{}',
+    reason          JSONB,
+    assigned_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    assigned_by     TEXT NOT NULL CHECK (assigned_by IN ('create', 'reassign', 'backfill')),
+    replaced_at     TIMESTAMPTZ,
+    replaced_by     TEXT REFERENCES users(user_id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+-- A user holds at most one current assignment per PR; replaced rows are kept as history.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pr_reviewers_current
+    ON pr_reviewers (pull_request_id, user_id)
+    WHERE replaced_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_user_id_current
+    ON pr_reviewers (user_id)
+    WHERE replaced_at IS NULL;
+
+-- Backfill from the reviewer arrays, keeping their order and metadata.
+-- Reviewers that no longer exist in users are dropped.
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM information_schema.columns
+        WHERE table_name = 'pull_requests'
+          AND column_name = 'assigned_reviewers'
+    ) THEN
+        INSERT INTO pr_reviewers (
+            pull_request_id,
+            user_id,
+            position,
+            pool,
+            matched_skills,
+            reason,
+            assigned_at,
+            assigned_by
+        )
+        SELECT DISTINCT ON (pr.pull_request_id, r.user_id)
+            pr.pull_request_id,
+            r.user_id,
+            r.position,
+            COALESCE(pr.reviewer_assignments -> r.user_id ->> 'pool', ''),
+            ARRAY(
+                SELECT jsonb_array_elements_text(
+                    COALESCE(pr.reviewer_assignments -> r.user_id -> 'matched_skills', '[]'::jsonb)
+                )
+            ),
+            pr.reviewer_assignments -> r.user_id -> 'reason',
+            COALESCE(pr.created_at, now()),
+            'backfill'
+        FROM pull_requests pr
+        CROSS JOIN LATERAL unnest(pr.assigned_reviewers) WITH ORDINALITY AS r(user_id, position)
+        JOIN users u ON u.user_id = r.user_id
+        ORDER BY pr.pull_request_id, r.user_id, r.position;
+
+        DROP INDEX IF EXISTS idx_pull_requests_assigned_reviewers;
+
+        ALTER TABLE pull_requests
+            DROP COLUMN assigned_reviewers,
+            DROP COLUMN IF EXISTS reviewer_assignments;
+    END IF;
+END
+$$;
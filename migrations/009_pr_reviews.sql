@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS pr_reviews (
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    user_id         TEXT NOT NULL REFERENCES users(user_id)
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+    state           TEXT NOT NULL CHECK (state IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    comment         TEXT NOT NULL DEFAULT '',
+    submitted_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reviews_pr_user_submitted_at
+    ON pr_reviews (pull_request_id, user_id, submitted_at DESC);
@@ -0,0 +1,7 @@
+ALTER TABLE team_settings
+    ADD COLUMN IF NOT EXISTS required_approvals         INTEGER NOT NULL DEFAULT 0
+        CHECK (required_approvals >= 0),
+    ADD COLUMN IF NOT EXISTS block_on_changes_requested BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS force_merged BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,20 @@
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_status_check
+        CHECK (status IN ('DRAFT', 'OPEN', 'CLOSED', 'MERGED'));
+
+-- repository and changed_files are kept so that reviewers can be assigned
+-- by CODEOWNERS rules when a draft becomes ready for review.
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS closed_at     TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS repository    TEXT,
+    ADD COLUMN IF NOT EXISTS changed_files TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE pr_reviewers
+    DROP CONSTRAINT IF EXISTS pr_reviewers_assigned_by_check;
+
+ALTER TABLE pr_reviewers
+    ADD CONSTRAINT pr_reviewers_assigned_by_check
+        CHECK (assigned_by IN ('create', 'ready', 'reassign', 'backfill'));
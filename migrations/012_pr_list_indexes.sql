@@ -0,0 +1,25 @@
+-- /pullRequest/list pages by (created_at, pull_request_id), so created_at must be set.
+UPDATE pull_requests
+SET created_at = COALESCE(merged_at, now())
+WHERE created_at IS NULL;
+
+ALTER TABLE pull_requests
+    ALTER COLUMN created_at SET NOT NULL,
+    ALTER COLUMN created_at SET DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at_id
+    ON pull_requests (created_at, pull_request_id);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status_created_at_id
+    ON pull_requests (status, created_at, pull_request_id);
+
+-- Pages of one author's PRs; idx_pull_requests_author_id is kept for the author_id foreign key checks.
+CREATE INDEX IF NOT EXISTS idx_pull_requests_author_id_created_at_id
+    ON pull_requests (author_id, created_at, pull_request_id);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged_at
+    ON pull_requests (merged_at)
+    WHERE merged_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_team_name
+    ON users (team_name);
@@ -0,0 +1,4 @@
+-- /pullRequest/get reads all assignments of a PR, replaced ones included,
+-- which idx_pr_reviewers_current (current rows only) does not cover.
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pr_assigned_at
+    ON pr_reviewers (pull_request_id, assigned_at);
@@ -0,0 +1,4 @@
+-- Users removed from a team stay in the system without a team: they keep their
+-- authored PRs and review history but are no longer in any reviewer pool.
+ALTER TABLE users
+    ALTER COLUMN team_name DROP NOT NULL;
@@ -0,0 +1,16 @@
+-- Audit log of /users/transfer. Team names are stored as they were at the time
+-- of the transfer, so they are not foreign keys.
+CREATE TABLE IF NOT EXISTS user_transfers (
+    id             BIGSERIAL PRIMARY KEY,
+    user_id        TEXT NOT NULL REFERENCES users(user_id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    from_team      TEXT,
+    to_team        TEXT NOT NULL,
+    reviews        TEXT NOT NULL CHECK (reviews IN ('reassign', 'keep')),
+    reassigned     INTEGER NOT NULL DEFAULT 0,
+    transferred_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_transfers_user_id_transferred_at
+    ON user_transfers (user_id, transferred_at DESC);
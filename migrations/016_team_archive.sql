@@ -0,0 +1,4 @@
+-- Archived teams keep their members and history but are excluded from reviewer
+-- pools and statistics.
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
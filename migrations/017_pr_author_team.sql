@@ -0,0 +1,28 @@
+-- The team the author was in when the PR was created. A team with such PRs cannot be
+-- deleted, even after the authors have left it; a rename follows through the cascade.
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS author_team_name TEXT REFERENCES teams(team_name)
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT;
+
+-- Existing PRs get the team the author left by the first later transfer when that team
+-- still exists under the same name, and the author's current team otherwise.
+UPDATE pull_requests p
+SET author_team_name = COALESCE(
+    (
+        SELECT t.from_team
+        FROM user_transfers t
+        JOIN teams tm ON tm.team_name = t.from_team
+        WHERE t.user_id = p.author_id
+          AND t.transferred_at > p.created_at
+        ORDER BY t.transferred_at
+        LIMIT 1
+    ),
+    (SELECT u.team_name FROM users u WHERE u.user_id = p.author_id)
+)
+WHERE p.author_team_name IS NULL;
+
+-- Serves both the team's PR count and /pullRequest/list pages filtered by team_name,
+-- next to the status and author indexes of 012_pr_list_indexes.sql.
+CREATE INDEX IF NOT EXISTS idx_pull_requests_author_team_name_created_at_id
+    ON pull_requests (author_team_name, created_at, pull_request_id);
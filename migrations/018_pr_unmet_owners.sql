@@ -0,0 +1,4 @@
+-- Owning teams by CODEOWNERS rules that had no eligible reviewer when reviewers were
+-- assigned. The PR is created anyway, and the gap is kept visible on it.
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS unmet_owners TEXT[] NOT NULL DEFAULT '{}';
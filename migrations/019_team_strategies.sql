@@ -0,0 +1,5 @@
+-- Assignment strategies chosen by a team for its pool; NULL keeps the server default
+-- from ASSIGNMENT_STRATEGY or REASSIGN_STRATEGY.
+ALTER TABLE team_settings
+    ADD COLUMN IF NOT EXISTS assignment_strategy TEXT,
+    ADD COLUMN IF NOT EXISTS reassign_strategy   TEXT;
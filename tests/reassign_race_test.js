@@ -0,0 +1,93 @@
+import http from 'k6/http';
+import { check, fail } from 'k6';
+
+// Races reassignments of every reviewer of a PR against its merge and checks
+// that the reviewers never change after MERGED and no update is lost.
+export const options = {
+  vus: 20,
+  iterations: 400,
+  thresholds: {
+    checks: ['rate==1.0'],
+  },
+};
+
+const BASE_URL = 'http://localhost:8080';
+const PARAMS = { headers: { 'Content-Type': 'application/json' } };
+const REASSIGNS_PER_REVIEWER = 3;
+
+function post(path, body) {
+  return {
+    method: 'POST',
+    url: `${BASE_URL}${path}`,
+    body: JSON.stringify(body),
+    params: PARAMS,
+  };
+}
+
+export default function () {
+  const iter = `race-${__VU}-${__ITER}`;
+
+  const members = [];
+  for (let i = 1; i <= 8; i++) {
+    members.push({ user_id: `u-${iter}-${i}`, username: `user-${iter}-${i}`, is_active: true });
+  }
+
+  let res = http.post(
+    `${BASE_URL}/team/add`,
+    JSON.stringify({ team_name: `team-${iter}`, members }),
+    PARAMS,
+  );
+  check(res, { 'create_team: 201': (r) => r.status === 201 });
+
+  const prId = `pr-${iter}`;
+  res = http.post(
+    `${BASE_URL}/pullRequest/create`,
+    JSON.stringify({ pull_request_id: prId, pull_request_name: `PR ${iter}`, author_id: members[0].user_id }),
+    PARAMS,
+  );
+  if (!check(res, { 'create_pr: 201': (r) => r.status === 201 })) {
+    fail(`create pr ${prId}: ${res.status} ${res.body}`);
+  }
+  const reviewers = res.json('pr.assigned_reviewers');
+
+  const requests = [];
+  for (const reviewer of reviewers) {
+    for (let i = 0; i < REASSIGNS_PER_REVIEWER; i++) {
+      requests.push(post('/pullRequest/reassign', { pull_request_id: prId, old_user_id: reviewer }));
+    }
+  }
+  const mergeIdx = Math.floor(requests.length / 2);
+  requests.splice(mergeIdx, 0, post('/pullRequest/merge', { pull_request_id: prId }));
+
+  const responses = http.batch(requests);
+  const merge = responses[mergeIdx];
+  const reassigns = responses.filter((_, i) => i !== mergeIdx);
+
+  check(merge, { 'merge: 200': (r) => r.status === 200 });
+  check(reassigns, {
+    'reassign: 200 or 409 only': (rs) => rs.every((r) => r.status === 200 || r.status === 409),
+  });
+
+  // Every successful reassign replaced a distinct current reviewer, so the
+  // reviewer count never changes and no user holds two assignments.
+  const merged = merge.json('pr.assigned_reviewers');
+  check(merged, {
+    'merged: reviewer count kept': (m) => m.length === reviewers.length,
+    'merged: no duplicate reviewers': (m) => new Set(m).size === m.length,
+  });
+
+  // Reassigns attempted after the merge must be rejected and change nothing.
+  res = http.post(
+    `${BASE_URL}/pullRequest/reassign`,
+    JSON.stringify({ pull_request_id: prId, old_user_id: merged[0] }),
+    PARAMS,
+  );
+  check(res, { 'reassign after merge: PR_MERGED': (r) => r.status === 409 && r.json('error.code') === 'PR_MERGED' });
+
+  res = http.get(`${BASE_URL}/pullRequest/get?pull_request_id=${prId}`);
+  check(res, {
+    'get: status MERGED': (r) => r.json('pr.status') === 'MERGED',
+    'get: reviewers unchanged since merge': (r) =>
+      JSON.stringify(r.json('pr.assigned_reviewers')) === JSON.stringify(merged),
+  });
+}